@@ -5,52 +5,290 @@
 package queues
 
 import (
+	"context"
 	"sync"
 )
 
+// closedChan is returned by readiness methods when the state is already reached.
+var closedChan = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+// BlockingBounded is a synchronized ring buffer. Blocked readers and writers
+// wait for notification channels instead of condition variables so the
+// waiting can be interrupted by a context.
 type BlockingBounded[T any] struct {
-	mu      sync.RWMutex
-	rCond   *sync.Cond
-	wCond   *sync.Cond
-	elems   []T
-	wOffset int
-	rOffset int
-	full    bool
-	closed  bool
+	mu       sync.Mutex
+	rNotify  chan struct{} // closed when the queue may have become readable
+	wNotify  chan struct{} // closed when the queue may have become writeable
+	rWaiters int
+	wWaiters int
+	rWatched bool // whether rNotify was handed out by Readable
+	wWatched bool // whether wNotify was handed out by Writable
+	done     chan struct{}
+	elems    []T
+	wOffset  int
+	rOffset  int
+	full     bool
+	closed   bool
 }
 
 func (queue *BlockingBounded[T]) Init(cap int) {
 	queue.elems = make([]T, cap, cap)
-	queue.rCond = sync.NewCond(&queue.mu)
-	queue.wCond = sync.NewCond(&queue.mu)
+	queue.rNotify = make(chan struct{})
+	queue.wNotify = make(chan struct{})
+	queue.done = make(chan struct{})
 }
 
 func (queue *BlockingBounded[T]) Push(elem T) (ok bool) {
+	ok, _ = queue.PushContext(context.Background(), elem)
+	return ok
+}
+
+// PushContext is similar to Push but it stops waiting for a room when ctx is done.
+// Return value err is ctx.Err() in this case.
+func (queue *BlockingBounded[T]) PushContext(ctx context.Context, elem T) (ok bool, err error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if ok, err = queue.waitWriteable(ctx); !ok {
+		return false, err
+	}
+	queue.push(elem)
+	return true, nil
+}
+
+// TryPush attempts to push an element without blocking. Return value pushed
+// is false if the queue is full and ok is false if the queue is closed.
+func (queue *BlockingBounded[T]) TryPush(elem T) (pushed bool, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return false, false
+	}
+	if queue.full {
+		return false, true
+	}
+	queue.push(elem)
+	return true, true
+}
+
+// PushOverwrite adds an element to the queue without blocking. If the queue
+// is full, the oldest element is overwritten and return value overwritten is
+// true. Return value ok is false if the queue is closed.
+func (queue *BlockingBounded[T]) PushOverwrite(elem T) (overwritten bool, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return false, false
+	}
+	if queue.full {
+		queue.discard(1)
+		overwritten = true
+	}
+	queue.push(elem)
+	return overwritten, true
+}
+
+// PushAllOverwrite adds all the slice elements to the queue without blocking
+// overwriting the oldest ones if there is not enough room. Return value
+// overwritten is the number of overwritten elements including the incoming
+// ones that didn't fit at all. Return value ok is false if the queue is closed.
+func (queue *BlockingBounded[T]) PushAllOverwrite(values []T) (overwritten int, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return 0, false
+	}
+	if len(values) == 0 {
+		return 0, true
+	}
+	if len(values) > len(queue.elems) {
+		overwritten = len(values) - len(queue.elems)
+		values = values[overwritten:]
+	}
+	if excess := len(values) - queue.available(); excess > 0 {
+		queue.discard(excess)
+		overwritten += excess
+	}
+	queue.pushSome(values)
+	return overwritten, true
+}
+
+func (queue *BlockingBounded[T]) PushSomeNonEmpty(values []T) (n int) {
+	n, _ = queue.PushSomeNonEmptyContext(context.Background(), values)
+	return n
+}
+
+// PushSomeNonEmptyContext is similar to PushSomeNonEmpty but it stops waiting
+// for a room when ctx is done. Return value err is ctx.Err() in this case.
+func (queue *BlockingBounded[T]) PushSomeNonEmptyContext(ctx context.Context, values []T) (n int, err error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if ok, err := queue.waitWriteable(ctx); !ok {
+		return 0, err
+	}
+	return queue.pushSome(values), nil
+}
+
+// TryPushSome attempts to push some of the slice elements without blocking.
+// Return value ok is false if the queue is closed.
+func (queue *BlockingBounded[T]) TryPushSome(values []T) (n int, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return 0, false
+	}
+	if queue.full || len(values) == 0 {
+		return 0, true
+	}
+	return queue.pushSome(values), true
+}
+
+func (queue *BlockingBounded[T]) Pop() (value T, ok bool) {
+	value, ok, _ = queue.PopContext(context.Background())
+	return value, ok
+}
+
+// PopContext is similar to Pop but it stops waiting for an element when ctx is done.
+// Return value err is ctx.Err() in this case.
+func (queue *BlockingBounded[T]) PopContext(ctx context.Context) (value T, ok bool, err error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if ok, err = queue.waitReadable(ctx); !ok {
+		return value, false, err
+	}
+	return queue.pop(), true, nil
+}
+
+// TryPop attempts to pop an element without blocking.
+// Return value ok is false if the queue is empty.
+func (queue *BlockingBounded[T]) TryPop() (value T, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.empty() {
+		return value, false
+	}
+	return queue.pop(), true
+}
+
+func (queue *BlockingBounded[T]) PopSomeNonEmpty(out []T) (n int) {
+	n, _ = queue.PopSomeNonEmptyContext(context.Background(), out)
+	return n
+}
+
+// PopSomeNonEmptyContext is similar to PopSomeNonEmpty but it stops waiting
+// for elements when ctx is done. Return value err is ctx.Err() in this case.
+func (queue *BlockingBounded[T]) PopSomeNonEmptyContext(ctx context.Context, out []T) (n int, err error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if ok, err := queue.waitReadable(ctx); !ok {
+		return 0, err
+	}
+	return queue.popSome(out), nil
+}
+
+// TryPopSome attempts to pop a bunch of elements without blocking.
+func (queue *BlockingBounded[T]) TryPopSome(out []T) (n int) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.empty() || len(out) == 0 {
+		return 0
+	}
+	return queue.popSome(out)
+}
+
+func (queue *BlockingBounded[T]) Close() (ok bool) {
+	queue.mu.Lock()
+	if ok = !queue.closed; ok {
+		queue.closed = true
+		close(queue.done)
+		queue.notifyReadable()
+		queue.notifyWriteable()
+	}
+	queue.mu.Unlock()
+	return ok
+}
+
+func (queue *BlockingBounded[T]) Closed() bool {
+	queue.mu.Lock()
+	result := queue.closed
+	queue.mu.Unlock()
+	return result
+}
+
+// Readable returns a channel that is closed as soon as the queue becomes
+// either non-empty or closed. If it's already so, the channel is closed.
+func (queue *BlockingBounded[T]) Readable() <-chan struct{} {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if !queue.empty() || queue.closed {
+		return closedChan
+	}
+	queue.rWatched = true
+	return queue.rNotify
+}
+
+// Writable returns a channel that is closed as soon as the queue becomes
+// either non-full or closed. If it's already so, the channel is closed.
+func (queue *BlockingBounded[T]) Writable() <-chan struct{} {
 	queue.mu.Lock()
-	if !queue.waitWriteable() {
-		queue.mu.Unlock()
-		return false
+	defer queue.mu.Unlock()
+
+	if !queue.full || queue.closed {
+		return closedChan
 	}
+	queue.wWatched = true
+	return queue.wNotify
+}
+
+// Done returns a channel that is closed when the queue is closed.
+func (queue *BlockingBounded[T]) Done() <-chan struct{} {
+	return queue.done
+}
+
+func (queue *BlockingBounded[T]) Len() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.len()
+}
+
+func (queue *BlockingBounded[T]) Cap() int {
+	return queue.cap()
+}
+
+func (queue *BlockingBounded[T]) Available() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.available()
+}
+
+// push adds an element to a non-full queue.
+func (queue *BlockingBounded[T]) push(elem T) {
 	queue.elems[queue.wOffset] = elem
 	if queue.wOffset += 1; queue.wOffset == len(queue.elems) {
 		queue.wOffset = 0
 	}
 	if queue.wOffset == queue.rOffset {
 		queue.full = true
-	} else {
-		queue.wCond.Signal()
 	}
-	queue.rCond.Signal()
-	queue.mu.Unlock()
-	return true
+	queue.notifyReadable()
 }
 
-func (queue *BlockingBounded[T]) PushSomeNonEmpty(values []T) (n int) {
-	queue.mu.Lock()
-	if !queue.waitWriteable() {
-		queue.mu.Unlock()
-		return 0
-	}
+// pushSome adds some of non-empty slice elements to a non-full queue.
+func (queue *BlockingBounded[T]) pushSome(values []T) (n int) {
 	if queue.wOffset >= queue.rOffset {
 		n = copy(queue.elems[queue.wOffset:], values)
 		if n < len(values) {
@@ -68,39 +306,24 @@ func (queue *BlockingBounded[T]) PushSomeNonEmpty(values []T) (n int) {
 	}
 	if queue.wOffset == queue.rOffset {
 		queue.full = true
-	} else {
-		queue.wCond.Signal()
 	}
-	queue.rCond.Signal()
-	queue.mu.Unlock()
+	queue.notifyReadable()
 	return n
 }
 
-func (queue *BlockingBounded[T]) Pop() (value T, ok bool) {
-	queue.mu.Lock()
-	if !queue.waitReadable() {
-		queue.mu.Unlock()
-		return value, false
-	}
+// pop consumes an element from a non-empty queue.
+func (queue *BlockingBounded[T]) pop() (value T) {
 	value = queue.elems[queue.rOffset]
 	if queue.rOffset += 1; queue.rOffset == len(queue.elems) {
 		queue.rOffset = 0
 	}
 	queue.full = false
-	if queue.wOffset != queue.rOffset {
-		queue.rCond.Signal()
-	}
-	queue.wCond.Signal()
-	queue.mu.Unlock()
-	return value, true
+	queue.notifyWriteable()
+	return value
 }
 
-func (queue *BlockingBounded[T]) PopSomeNonEmpty(out []T) (n int) {
-	queue.mu.Lock()
-	if !queue.waitReadable() {
-		queue.mu.Unlock()
-		return 0
-	}
+// popSome consumes a bunch of elements from a non-empty queue to a non-empty slice.
+func (queue *BlockingBounded[T]) popSome(out []T) (n int) {
 	if queue.wOffset < queue.rOffset || queue.full {
 		n = copy(out, queue.elems[queue.rOffset:])
 		if n < len(out) {
@@ -117,50 +340,76 @@ func (queue *BlockingBounded[T]) PopSomeNonEmpty(out []T) (n int) {
 		queue.rOffset += n
 	}
 	queue.full = false
-	if queue.wOffset != queue.rOffset {
-		queue.rCond.Signal()
-	}
-	queue.wCond.Signal()
-	queue.mu.Unlock()
+	queue.notifyWriteable()
 	return n
 }
 
-func (queue *BlockingBounded[T]) Close() (ok bool) {
-	queue.mu.Lock()
-	if ok = !queue.closed; ok {
-		queue.closed = true
-		queue.rCond.Broadcast()
-		queue.wCond.Broadcast()
+// discard drops n oldest elements from the queue without notifying writers
+// since the room is taken right away.
+func (queue *BlockingBounded[T]) discard(n int) {
+	if queue.rOffset += n; queue.rOffset >= len(queue.elems) {
+		queue.rOffset -= len(queue.elems)
 	}
-	queue.mu.Unlock()
-	return ok
-}
-
-func (queue *BlockingBounded[T]) Closed() bool {
-	queue.mu.RLock()
-	result := queue.closed
-	queue.mu.RUnlock()
-	return result
+	queue.full = false
 }
 
-func (queue *BlockingBounded[T]) waitWriteable() (ok bool) {
-	for queue.full {
-		if queue.closed {
-			return false
+// waitWriteable blocks until the queue is either not full or closed.
+// Return value ok is false if the queue is closed or ctx is done,
+// in the latter case err is ctx.Err().
+func (queue *BlockingBounded[T]) waitWriteable(ctx context.Context) (ok bool, err error) {
+	for queue.full && !queue.closed {
+		if err := queue.wait(ctx, queue.wNotify, &queue.wWaiters); err != nil {
+			return false, err
 		}
-		queue.wCond.Wait()
 	}
-	return !queue.closed
+	return !queue.closed, nil
 }
 
-func (queue *BlockingBounded[T]) waitReadable() (ok bool) {
+// waitReadable blocks until the queue is either not empty or closed.
+// Return value ok is false if the queue is closed and empty or ctx is done,
+// in the latter case err is ctx.Err().
+func (queue *BlockingBounded[T]) waitReadable(ctx context.Context) (ok bool, err error) {
 	for queue.empty() {
 		if queue.closed {
-			return false
+			return false, nil
+		}
+		if err := queue.wait(ctx, queue.rNotify, &queue.rWaiters); err != nil {
+			return false, err
 		}
-		queue.rCond.Wait()
 	}
-	return true
+	return true, nil
+}
+
+// wait releases the lock until either notify is closed or ctx is done.
+func (queue *BlockingBounded[T]) wait(ctx context.Context, notify chan struct{}, waiters *int) (err error) {
+	*waiters += 1
+	queue.mu.Unlock()
+	select {
+	case <-notify:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	queue.mu.Lock()
+	*waiters -= 1
+	return err
+}
+
+// notifyReadable wakes up all the blocked readers and watchers.
+func (queue *BlockingBounded[T]) notifyReadable() {
+	if queue.rWaiters > 0 || queue.rWatched {
+		close(queue.rNotify)
+		queue.rNotify = make(chan struct{})
+		queue.rWatched = false
+	}
+}
+
+// notifyWriteable wakes up all the blocked writers and watchers.
+func (queue *BlockingBounded[T]) notifyWriteable() {
+	if queue.wWaiters > 0 || queue.wWatched {
+		close(queue.wNotify)
+		queue.wNotify = make(chan struct{})
+		queue.wWatched = false
+	}
 }
 
 func (queue *BlockingBounded[T]) cap() int {
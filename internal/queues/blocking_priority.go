@@ -0,0 +1,243 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"context"
+	"sync"
+)
+
+// BlockingPriority is a synchronized priority queue of a fixed capacity.
+// Similarly to [BlockingBounded], blocked readers and writers wait for
+// notification channels so the waiting can be interrupted by a context.
+type BlockingPriority[T any] struct {
+	mu       sync.Mutex
+	rNotify  chan struct{} // closed when the queue may have become readable
+	wNotify  chan struct{} // closed when the queue may have become writeable
+	rWaiters int
+	wWaiters int
+	q        Priority[T]
+	closed   bool
+}
+
+func (queue *BlockingPriority[T]) Init(less func(a, b T) bool, cap int) {
+	queue.q.Init(less, cap)
+	queue.rNotify = make(chan struct{})
+	queue.wNotify = make(chan struct{})
+}
+
+func (queue *BlockingPriority[T]) Push(elem T) (ok bool) {
+	ok, _ = queue.PushContext(context.Background(), elem)
+	return ok
+}
+
+// PushContext is similar to Push but it stops waiting for a room when ctx is done.
+// Return value err is ctx.Err() in this case.
+func (queue *BlockingPriority[T]) PushContext(ctx context.Context, elem T) (ok bool, err error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if ok, err = queue.waitWriteable(ctx); !ok {
+		return false, err
+	}
+	queue.q.Push(elem)
+	queue.notifyReadable()
+	return true, nil
+}
+
+// TryPush attempts to push an element without blocking. Return value pushed
+// is false if the queue is full and ok is false if the queue is closed.
+func (queue *BlockingPriority[T]) TryPush(elem T) (pushed bool, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return false, false
+	}
+	if !queue.q.Push(elem) {
+		return false, true
+	}
+	queue.notifyReadable()
+	return true, true
+}
+
+func (queue *BlockingPriority[T]) PushSomeNonEmpty(values []T) (n int) {
+	n, _ = queue.PushSomeNonEmptyContext(context.Background(), values)
+	return n
+}
+
+// PushSomeNonEmptyContext is similar to PushSomeNonEmpty but it stops waiting
+// for a room when ctx is done. Return value err is ctx.Err() in this case.
+func (queue *BlockingPriority[T]) PushSomeNonEmptyContext(ctx context.Context, values []T) (n int, err error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if ok, err := queue.waitWriteable(ctx); !ok {
+		return 0, err
+	}
+	n = queue.q.PushSome(values)
+	queue.notifyReadable()
+	return n, nil
+}
+
+// TryPushSome attempts to push some of the slice elements without blocking.
+// Return value ok is false if the queue is closed.
+func (queue *BlockingPriority[T]) TryPushSome(values []T) (n int, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return 0, false
+	}
+	if n = queue.q.PushSome(values); n > 0 {
+		queue.notifyReadable()
+	}
+	return n, true
+}
+
+func (queue *BlockingPriority[T]) Pop() (value T, ok bool) {
+	value, ok, _ = queue.PopContext(context.Background())
+	return value, ok
+}
+
+// PopContext is similar to Pop but it stops waiting for an element when ctx is done.
+// Return value err is ctx.Err() in this case.
+func (queue *BlockingPriority[T]) PopContext(ctx context.Context) (value T, ok bool, err error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if ok, err = queue.waitReadable(ctx); !ok {
+		return value, false, err
+	}
+	value, ok = queue.q.Pop()
+	queue.notifyWriteable()
+	return value, ok, nil
+}
+
+// TryPop attempts to pop an element without blocking.
+// Return value ok is false if the queue is empty.
+func (queue *BlockingPriority[T]) TryPop() (value T, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if value, ok = queue.q.Pop(); ok {
+		queue.notifyWriteable()
+	}
+	return value, ok
+}
+
+func (queue *BlockingPriority[T]) PopSomeNonEmpty(out []T) (n int) {
+	n, _ = queue.PopSomeNonEmptyContext(context.Background(), out)
+	return n
+}
+
+// PopSomeNonEmptyContext is similar to PopSomeNonEmpty but it stops waiting
+// for elements when ctx is done. Return value err is ctx.Err() in this case.
+func (queue *BlockingPriority[T]) PopSomeNonEmptyContext(ctx context.Context, out []T) (n int, err error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if ok, err := queue.waitReadable(ctx); !ok {
+		return 0, err
+	}
+	n = queue.q.PopSome(out)
+	queue.notifyWriteable()
+	return n, nil
+}
+
+// TryPopSome attempts to pop a bunch of elements without blocking.
+func (queue *BlockingPriority[T]) TryPopSome(out []T) (n int) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if n = queue.q.PopSome(out); n > 0 {
+		queue.notifyWriteable()
+	}
+	return n
+}
+
+func (queue *BlockingPriority[T]) Close() (ok bool) {
+	queue.mu.Lock()
+	if ok = !queue.closed; ok {
+		queue.closed = true
+		queue.notifyReadable()
+		queue.notifyWriteable()
+	}
+	queue.mu.Unlock()
+	return ok
+}
+
+func (queue *BlockingPriority[T]) Closed() bool {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.closed
+}
+
+func (queue *BlockingPriority[T]) Len() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.q.Len()
+}
+
+func (queue *BlockingPriority[T]) Cap() int {
+	return queue.q.Cap()
+}
+
+// waitWriteable blocks until the queue is either not full or closed.
+// Return value ok is false if the queue is closed or ctx is done,
+// in the latter case err is ctx.Err().
+func (queue *BlockingPriority[T]) waitWriteable(ctx context.Context) (ok bool, err error) {
+	for queue.q.Full() && !queue.closed {
+		if err := queue.wait(ctx, queue.wNotify, &queue.wWaiters); err != nil {
+			return false, err
+		}
+	}
+	return !queue.closed, nil
+}
+
+// waitReadable blocks until the queue is either not empty or closed.
+// Return value ok is false if the queue is closed and empty or ctx is done,
+// in the latter case err is ctx.Err().
+func (queue *BlockingPriority[T]) waitReadable(ctx context.Context) (ok bool, err error) {
+	for queue.q.Empty() {
+		if queue.closed {
+			return false, nil
+		}
+		if err := queue.wait(ctx, queue.rNotify, &queue.rWaiters); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// wait releases the lock until either notify is closed or ctx is done.
+func (queue *BlockingPriority[T]) wait(ctx context.Context, notify chan struct{}, waiters *int) (err error) {
+	*waiters += 1
+	queue.mu.Unlock()
+	select {
+	case <-notify:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	queue.mu.Lock()
+	*waiters -= 1
+	return err
+}
+
+// notifyReadable wakes up all the blocked readers.
+func (queue *BlockingPriority[T]) notifyReadable() {
+	if queue.rWaiters > 0 {
+		close(queue.rNotify)
+		queue.rNotify = make(chan struct{})
+	}
+}
+
+// notifyWriteable wakes up all the blocked writers.
+func (queue *BlockingPriority[T]) notifyWriteable() {
+	if queue.wWaiters > 0 {
+		close(queue.wNotify)
+		queue.wNotify = make(chan struct{})
+	}
+}
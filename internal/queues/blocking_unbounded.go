@@ -0,0 +1,114 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"sync"
+)
+
+type BlockingUnbounded[T any] struct {
+	mu     sync.Mutex
+	rCond  *sync.Cond
+	q      Unbounded[T]
+	limit  int
+	closed bool
+}
+
+// Init initializes the queue. A positive limit is the maximum number
+// of elements the queue holds, otherwise the queue is truly unbounded.
+func (queue *BlockingUnbounded[T]) Init(limit int) {
+	queue.q.Init()
+	queue.rCond = sync.NewCond(&queue.mu)
+	queue.limit = limit
+}
+
+func (queue *BlockingUnbounded[T]) Push(elem T) (pushed bool, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return false, false
+	}
+	if queue.limit > 0 && queue.q.Len() >= queue.limit {
+		return false, true
+	}
+	queue.q.Push(elem)
+	queue.rCond.Signal()
+	return true, true
+}
+
+func (queue *BlockingUnbounded[T]) PushSome(values []T) (n int, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return 0, false
+	}
+	if queue.limit > 0 {
+		values = values[:min(len(values), max(queue.limit-queue.q.Len(), 0))]
+	}
+	if len(values) == 0 {
+		return 0, true
+	}
+	queue.q.PushAll(values)
+	queue.rCond.Broadcast()
+	return len(values), true
+}
+
+func (queue *BlockingUnbounded[T]) Pop() (value T, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if !queue.waitReadable() {
+		return value, false
+	}
+	return queue.q.Pop()
+}
+
+func (queue *BlockingUnbounded[T]) PopSomeNonEmpty(out []T) (n int) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if !queue.waitReadable() {
+		return 0
+	}
+	n = queue.q.PopSome(out)
+	if !queue.q.Empty() {
+		queue.rCond.Signal()
+	}
+	return n
+}
+
+func (queue *BlockingUnbounded[T]) Close() (ok bool) {
+	queue.mu.Lock()
+	if ok = !queue.closed; ok {
+		queue.closed = true
+		queue.rCond.Broadcast()
+	}
+	queue.mu.Unlock()
+	return ok
+}
+
+func (queue *BlockingUnbounded[T]) Closed() bool {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.closed
+}
+
+func (queue *BlockingUnbounded[T]) Len() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.q.Len()
+}
+
+func (queue *BlockingUnbounded[T]) waitReadable() (ok bool) {
+	for queue.q.Empty() {
+		if queue.closed {
+			return false
+		}
+		queue.rCond.Wait()
+	}
+	return true
+}
@@ -54,6 +54,41 @@ func (queue *Bounded[T]) PushSome(values []T) (n int) {
 	return n
 }
 
+// PushOverwrite adds an element to the queue. If the queue is full,
+// the oldest element is overwritten and return value is true.
+func (queue *Bounded[T]) PushOverwrite(elem T) (overwritten bool) {
+	if queue.full {
+		queue.discard(1)
+		overwritten = true
+	}
+	queue.Push(elem)
+	return overwritten
+}
+
+// PushAllOverwrite adds all the slice elements to the queue overwriting
+// the oldest ones if there is not enough room. Return value is the number
+// of overwritten elements including the incoming ones that didn't fit at all.
+func (queue *Bounded[T]) PushAllOverwrite(values []T) (overwritten int) {
+	if len(values) > len(queue.elems) {
+		overwritten = len(values) - len(queue.elems)
+		values = values[overwritten:]
+	}
+	if excess := len(values) - queue.Available(); excess > 0 {
+		queue.discard(excess)
+		overwritten += excess
+	}
+	queue.PushSome(values)
+	return overwritten
+}
+
+// discard drops n oldest elements from the queue.
+func (queue *Bounded[T]) discard(n int) {
+	if queue.rOffset += n; queue.rOffset >= len(queue.elems) {
+		queue.rOffset -= len(queue.elems)
+	}
+	queue.full = false
+}
+
 func (queue *Bounded[T]) Pop() (value T, ok bool) {
 	if queue.wOffset == queue.rOffset && !queue.full {
 		return value, false
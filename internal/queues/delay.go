@@ -0,0 +1,126 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type delayEntry[T any] struct {
+	value T
+	at    time.Time
+}
+
+// Delay is a synchronized heap of elements ordered by their deadlines.
+// Blocked readers wait for a notification channel which is closed whenever
+// the earliest deadline changes, so they can reschedule their timers.
+type Delay[T any] struct {
+	mu      sync.Mutex
+	q       Priority[delayEntry[T]]
+	now     func() time.Time
+	after   func(time.Duration) <-chan time.Time
+	notify  chan struct{}
+	waiters int
+	closed  bool
+}
+
+func (queue *Delay[T]) Init(now func() time.Time, after func(time.Duration) <-chan time.Time) {
+	queue.q.Init(func(a, b delayEntry[T]) bool { return a.at.Before(b.at) }, 0)
+	queue.now = now
+	queue.after = after
+	queue.notify = make(chan struct{})
+}
+
+func (queue *Delay[T]) Push(elem T, at time.Time) (ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if queue.closed {
+		return false
+	}
+	head, ok := queue.q.Peek()
+	queue.q.Push(delayEntry[T]{value: elem, at: at})
+	if !ok || at.Before(head.at) {
+		queue.notifyEarliest()
+	}
+	return true
+}
+
+// PopContext blocks until the earliest element is due and consumes it.
+// Return value ok is false if the queue is closed and no element is due
+// or ctx is done, in the latter case err is ctx.Err().
+func (queue *Delay[T]) PopContext(ctx context.Context) (value T, ok bool, err error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	for {
+		var timer <-chan time.Time
+		if head, ok := queue.q.Peek(); ok {
+			d := head.at.Sub(queue.now())
+			if d <= 0 {
+				queue.q.Pop()
+				return head.value, true, nil
+			}
+			timer = queue.after(d)
+		}
+		if queue.closed {
+			return value, false, nil
+		}
+
+		queue.waiters += 1
+		notify := queue.notify
+		queue.mu.Unlock()
+		select {
+		case <-notify:
+		case <-timer:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		queue.mu.Lock()
+		queue.waiters -= 1
+		if err != nil {
+			return value, false, err
+		}
+	}
+}
+
+// TryPop consumes the earliest element if it's due.
+func (queue *Delay[T]) TryPop() (value T, ok bool) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	head, ok := queue.q.Peek()
+	if !ok || head.at.After(queue.now()) {
+		return value, false
+	}
+	queue.q.Pop()
+	return head.value, true
+}
+
+func (queue *Delay[T]) Close() (ok bool) {
+	queue.mu.Lock()
+	if ok = !queue.closed; ok {
+		queue.closed = true
+		queue.notifyEarliest()
+	}
+	queue.mu.Unlock()
+	return ok
+}
+
+func (queue *Delay[T]) Len() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.q.Len()
+}
+
+// notifyEarliest wakes up all the blocked readers.
+func (queue *Delay[T]) notifyEarliest() {
+	if queue.waiters > 0 {
+		close(queue.notify)
+		queue.notify = make(chan struct{})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+// priorityEntry is a heap element. Sequence numbers make the order
+// of elements of equal priority stable.
+type priorityEntry[T any] struct {
+	value T
+	seq   uint64
+}
+
+// Priority is a binary min-heap ordered by a less function.
+type Priority[T any] struct {
+	entries []priorityEntry[T]
+	less    func(a, b T) bool
+	seq     uint64
+	limit   int
+}
+
+// Init initializes the queue. A positive limit is the maximum number
+// of elements the queue holds, otherwise the queue is unbounded.
+func (queue *Priority[T]) Init(less func(a, b T) bool, limit int) {
+	queue.less = less
+	queue.limit = limit
+	if limit > 0 {
+		queue.entries = make([]priorityEntry[T], 0, limit)
+	}
+}
+
+func (queue *Priority[T]) Push(elem T) (ok bool) {
+	if queue.Full() {
+		return false
+	}
+	queue.entries = append(queue.entries, priorityEntry[T]{value: elem, seq: queue.seq})
+	queue.seq += 1
+	queue.up(len(queue.entries) - 1)
+	return true
+}
+
+func (queue *Priority[T]) PushSome(values []T) (n int) {
+	for _, elem := range values {
+		if !queue.Push(elem) {
+			break
+		}
+		n += 1
+	}
+	return n
+}
+
+func (queue *Priority[T]) Peek() (value T, ok bool) {
+	if len(queue.entries) == 0 {
+		return value, false
+	}
+	return queue.entries[0].value, true
+}
+
+func (queue *Priority[T]) Pop() (value T, ok bool) {
+	if len(queue.entries) == 0 {
+		return value, false
+	}
+	value = queue.entries[0].value
+	last := len(queue.entries) - 1
+	queue.entries[0] = queue.entries[last]
+	queue.entries[last] = priorityEntry[T]{}
+	queue.entries = queue.entries[:last]
+	queue.down(0)
+	queue.shrink()
+	return value, true
+}
+
+func (queue *Priority[T]) PopSome(out []T) (n int) {
+	n = min(len(out), len(queue.entries))
+	for i := range n {
+		out[i], _ = queue.Pop()
+	}
+	return n
+}
+
+func (queue *Priority[T]) Cap() int {
+	return queue.limit
+}
+
+// BufferCap returns the capacity of the underlying buffer.
+func (queue *Priority[T]) BufferCap() int {
+	return cap(queue.entries)
+}
+
+func (queue *Priority[T]) Len() int {
+	return len(queue.entries)
+}
+
+func (queue *Priority[T]) Available() int {
+	return queue.limit - len(queue.entries)
+}
+
+func (queue *Priority[T]) Full() bool {
+	return queue.limit > 0 && len(queue.entries) >= queue.limit
+}
+
+func (queue *Priority[T]) Empty() bool {
+	return len(queue.entries) == 0
+}
+
+// shrink halves the buffer of an unbounded queue when it's mostly unused
+// so the memory allocated during bursts is eventually released.
+func (queue *Priority[T]) shrink() {
+	if queue.limit == 0 && cap(queue.entries) > unboundedMinCap && len(queue.entries) <= cap(queue.entries)/4 {
+		entries := make([]priorityEntry[T], len(queue.entries), cap(queue.entries)/2)
+		copy(entries, queue.entries)
+		queue.entries = entries
+	}
+}
+
+// before reports whether the i-th entry must be popped before the j-th one.
+func (queue *Priority[T]) before(i, j int) bool {
+	a, b := &queue.entries[i], &queue.entries[j]
+	if queue.less(a.value, b.value) {
+		return true
+	}
+	if queue.less(b.value, a.value) {
+		return false
+	}
+	return a.seq < b.seq
+}
+
+func (queue *Priority[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !queue.before(i, parent) {
+			break
+		}
+		queue.entries[i], queue.entries[parent] = queue.entries[parent], queue.entries[i]
+		i = parent
+	}
+}
+
+func (queue *Priority[T]) down(i int) {
+	for {
+		child := 2*i + 1
+		if child >= len(queue.entries) {
+			break
+		}
+		if right := child + 1; right < len(queue.entries) && queue.before(right, child) {
+			child = right
+		}
+		if !queue.before(child, i) {
+			break
+		}
+		queue.entries[i], queue.entries[child] = queue.entries[child], queue.entries[i]
+		i = child
+	}
+}
@@ -4,33 +4,123 @@
 
 package queues
 
+// unboundedMinCap is the smallest capacity the buffer is ever shrunk to.
+const unboundedMinCap = 16
+
 type Unbounded[T any] struct {
-	elems []T
-	offset int
+	elems   []T
+	rOffset int
+	len     int
 }
 
 func (queue *Unbounded[T]) Init() {}
 
 func (queue *Unbounded[T]) Push(elem T) {
-	panic("TODO")
+	if queue.len == len(queue.elems) {
+		queue.grow(queue.len + 1)
+	}
+	wOffset := queue.rOffset + queue.len
+	if wOffset >= len(queue.elems) {
+		wOffset -= len(queue.elems)
+	}
+	queue.elems[wOffset] = elem
+	queue.len += 1
 }
 
 func (queue *Unbounded[T]) PushAll(values []T) {
-	panic("TODO")
+	if len(values) == 0 {
+		return
+	}
+	if queue.len+len(values) > len(queue.elems) {
+		queue.grow(queue.len + len(values))
+	}
+	wOffset := queue.rOffset + queue.len
+	if wOffset >= len(queue.elems) {
+		wOffset -= len(queue.elems)
+	}
+	n := copy(queue.elems[wOffset:], values)
+	copy(queue.elems, values[n:])
+	queue.len += len(values)
 }
 
 func (queue *Unbounded[T]) Pop() (value T, ok bool) {
-	panic("TODO")
+	if queue.len == 0 {
+		return value, false
+	}
+	var empty T
+	value = queue.elems[queue.rOffset]
+	queue.elems[queue.rOffset] = empty
+	if queue.rOffset += 1; queue.rOffset == len(queue.elems) {
+		queue.rOffset = 0
+	}
+	queue.len -= 1
+	queue.shrink()
+	return value, true
 }
 
 func (queue *Unbounded[T]) PopSome(out []T) (n int) {
-	panic("TODO")
+	n = min(len(out), queue.len)
+	if n == 0 {
+		return 0
+	}
+	m := copy(out[:n], queue.elems[queue.rOffset:])
+	clear(queue.elems[queue.rOffset : queue.rOffset+m])
+	if m < n {
+		copy(out[m:n], queue.elems)
+		clear(queue.elems[:n-m])
+	}
+	if queue.rOffset += n; queue.rOffset >= len(queue.elems) {
+		queue.rOffset -= len(queue.elems)
+	}
+	queue.len -= n
+	queue.shrink()
+	return n
+}
+
+func (queue *Unbounded[T]) Slice() []T {
+	if queue.len == 0 {
+		return nil
+	}
+	out := make([]T, queue.len)
+	n := copy(out, queue.elems[queue.rOffset:])
+	copy(out[n:], queue.elems)
+	return out
+}
+
+func (queue *Unbounded[T]) Cap() int {
+	return len(queue.elems)
 }
 
 func (queue *Unbounded[T]) Len() int {
-	return len(queue.elems) - queue.offset
+	return queue.len
 }
 
 func (queue *Unbounded[T]) Empty() bool {
-	return len(queue.elems) == queue.offset
+	return queue.len == 0
+}
+
+// shrink halves the buffer when it's mostly unused so the memory
+// allocated during bursts is eventually released.
+func (queue *Unbounded[T]) shrink() {
+	if len(queue.elems) > unboundedMinCap && queue.len <= len(queue.elems)/4 {
+		queue.resize(len(queue.elems) / 2)
+	}
+}
+
+// grow reallocates the buffer so it's able to hold at least n elements.
+func (queue *Unbounded[T]) grow(n int) {
+	cap := max(unboundedMinCap, 2*len(queue.elems))
+	for cap < n {
+		cap *= 2
+	}
+	queue.resize(cap)
+}
+
+// resize reallocates the buffer with a given capacity.
+func (queue *Unbounded[T]) resize(cap int) {
+	elems := make([]T, cap)
+	n := copy(elems[:queue.len], queue.elems[queue.rOffset:])
+	copy(elems[n:queue.len], queue.elems)
+	queue.elems = elems
+	queue.rOffset = 0
 }
@@ -6,15 +6,17 @@ package queues
 
 import (
 	"runtime"
-
-	"github.com/marshall-lee/dope/sync/futures"
 )
 
+// GoroutineCapture captures an outcome of a goroutine. It doesn't use
+// futures so the queues tests don't depend on the futures package,
+// which itself depends on the queues.
 type GoroutineCapture[T any] struct {
-	future *futures.Future[goroutineCaptureInner[T]]
+	inner *goroutineCaptureInner[T]
 }
 
 type goroutineCaptureInner[T any] struct {
+	done     chan struct{}
 	panicked bool
 	err      any
 	val      T
@@ -25,31 +27,31 @@ func GoCapture(f func()) GoroutineCapture[struct{}] {
 }
 
 func GoCaptureWithReturnValue[T any](f func() T) GoroutineCapture[T] {
-	future := futures.New[goroutineCaptureInner[T]]()
+	inner := &goroutineCaptureInner[T]{done: make(chan struct{})}
 
 	go func() {
+		defer close(inner.done)
 		defer func() {
 			if err := recover(); err != nil {
-				future.Complete(goroutineCaptureInner[T]{panicked: true, err: err})
+				inner.panicked = true
+				inner.err = err
 			}
 		}()
 
-		val := f()
-
-		future.Complete(goroutineCaptureInner[T]{val: val})
+		inner.val = f()
 	}()
 	runtime.Gosched()
 
-	return GoroutineCapture[T]{future}
+	return GoroutineCapture[T]{inner}
 }
 
 func (cap GoroutineCapture[T]) Done() <-chan struct{} {
-	return cap.future.Done()
+	return cap.inner.done
 }
 
 func (cap GoroutineCapture[T]) IsDone() bool {
 	select {
-	case <-cap.future.Done():
+	case <-cap.inner.done:
 		return true
 	default:
 		return false
@@ -57,25 +59,24 @@ func (cap GoroutineCapture[T]) IsDone() bool {
 }
 
 func (cap GoroutineCapture[T]) IsPanicked() bool {
-	res, err, _ := cap.future.Get()
-	if err != nil {
-		panic(err)
-	}
-	return res.panicked
+	return cap.get().panicked
 }
 
 func (cap GoroutineCapture[T]) Val() T {
-	res, err, _ := cap.future.Get()
-	if err != nil {
-		panic(err)
-	}
-	return res.val
+	return cap.get().val
 }
 
 func (cap GoroutineCapture[T]) Err() any {
-	res, err, _ := cap.future.Get()
-	if err != nil {
-		panic(err)
+	return cap.get().err
+}
+
+// get returns the outcome of a finished goroutine. A zero outcome is
+// returned while the goroutine is still running.
+func (cap GoroutineCapture[T]) get() goroutineCaptureInner[T] {
+	select {
+	case <-cap.inner.done:
+		return *cap.inner
+	default:
+		return goroutineCaptureInner[T]{}
 	}
-	return res.err
 }
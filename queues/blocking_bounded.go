@@ -5,9 +5,13 @@
 package queues
 
 import (
+	"context"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	internal "github.com/marshall-lee/dope/internal/queues"
+	"github.com/marshall-lee/dope/opt"
 )
 
 // BlockingBounded is a blocking FIFO queue of a fixed capacity.
@@ -23,65 +27,57 @@ import (
 // And when the queue is in an open state and full, all write methods block
 // until it becomes either partially full or empty.
 // Writing to a closed queue results in panic.
+//
+// Instead of blocking, write methods can drop the elements that don't fit
+// into the queue. This behaviour is configured using the [WithOverflow]
+// and [WithBlockTimeout] options.
 type BlockingBounded[T any] struct {
-	q internal.BlockingBounded[T]
+	q            internal.BlockingBounded[T]
+	overflow     OverflowPolicy
+	blockTimeout time.Duration
+	dropped      atomic.Uint64
 }
 
 // NewBlockingBounded makes a new blocking FIFO queue with a given capacity.
 // Capacity is expected to be a positive non-zero integer value, otherwise
-// this function panics.
-func NewBlockingBounded[T any](cap int) *BlockingBounded[T] {
+// this function panics. It respects the [Options.Overflow] and
+// [Options.BlockTimeout] options and panics if any other option is given.
+func NewBlockingBounded[T any](cap int, setters ...opt.Setter[Options]) *BlockingBounded[T] {
 	if cap <= 0 {
 		panic(fmt.Errorf("queues: capacity must be a positive integer value but %v is given", cap))
 	}
+	var opts Options
+	opt.Apply(&opts, setters...)
+	validateSupported(&opts, "BlockingBounded", optionOverflow|optionBlockTimeout)
+	validateOverflow(&opts)
 
-	var queue BlockingBounded[T]
+	queue := BlockingBounded[T]{overflow: opts.Overflow, blockTimeout: opts.BlockTimeout}
 	queue.q.Init(cap)
 	return &queue
 }
 
 // Push adds an element to the queue. This method blocks while the queue
 // is full and panics if the queue is closed.
+// Unless the overflow policy is [OverflowBlock], it never blocks.
 func (queue *BlockingBounded[T]) Push(elem T) {
-	if ok := queue.q.Push(elem); !ok {
-		panic(ErrPushClosed)
-	}
+	queue.push(context.Background(), elem)
 }
 
 // PushSome adds to the queue at least some of the slice elements. This method
 // blocks while the queue is full and panics if the queue is closed. Return value is the number of slice
-// elements added to the queue and it's always non-zero.
+// elements added to the queue and it's always non-zero unless the elements are dropped
+// according to the overflow policy.
 func (queue *BlockingBounded[T]) PushSome(values []T) (n int) {
-	if len(values) == 0 {
-		if queue.q.Closed() {
-			panic(ErrPushClosed)
-		}
-		return 0
-	}
-	if n = queue.q.PushSomeNonEmpty(values); n == 0 {
-		panic(ErrPushClosed)
-	}
+	n, _ = queue.PushSomeContext(context.Background(), values)
 	return n
 }
 
 // PushAll adds to the queue all the elements from the slice. This method blocks until
 // eventually the room is found for every element of the slice. If in the process of pushing elements
 // to the queue it was closed, this method panics.
+// Unless the overflow policy is [OverflowBlock], it never blocks.
 func (queue *BlockingBounded[T]) PushAll(values []T) {
-	var pushed bool
-	for len(values) != 0 {
-		m := queue.q.PushSomeNonEmpty(values)
-		if m == 0 {
-			panic(ErrPushClosed)
-		}
-		values = values[m:]
-		pushed = true
-	}
-
-	// No push was really attempted so lets check that queue is closed manually.
-	if !pushed && queue.q.Closed() {
-		panic(ErrPushClosed)
-	}
+	queue.PushAllContext(context.Background(), values)
 }
 
 // Pop attempts to consume one element from the queue. This method blocks while the queue is empty
@@ -100,6 +96,44 @@ func (queue *BlockingBounded[T]) PopSome(out []T) (n int) {
 	return queue.q.PopSomeNonEmpty(out)
 }
 
+// TryPush attempts to add an element to the queue without blocking. Return value
+// is false if the queue is full. Like [BlockingBounded.Push], it panics if the
+// queue is closed.
+// The overflow policy doesn't apply to this method.
+func (queue *BlockingBounded[T]) TryPush(elem T) (ok bool) {
+	pushed, ok := queue.q.TryPush(elem)
+	if !ok {
+		panic(ErrPushClosed)
+	}
+	return pushed
+}
+
+// TryPushSome attempts to add to the queue some of the slice elements without
+// blocking. Return value is the number of slice elements added to the queue.
+// Zero means that the queue is full. Like [BlockingBounded.PushSome], it panics
+// if the queue is closed.
+// The overflow policy doesn't apply to this method.
+func (queue *BlockingBounded[T]) TryPushSome(values []T) (n int) {
+	n, ok := queue.q.TryPushSome(values)
+	if !ok {
+		panic(ErrPushClosed)
+	}
+	return n
+}
+
+// TryPop attempts to consume one element from the queue without blocking.
+// Return value ok is false if the queue is empty, regardless of whether
+// it's closed or not.
+func (queue *BlockingBounded[T]) TryPop() (value T, ok bool) {
+	return queue.q.TryPop()
+}
+
+// TryPopSome attempts to consume a bunch of elements from the queue without
+// blocking. Return value is the number of elements consumed from the queue.
+func (queue *BlockingBounded[T]) TryPopSome(out []T) (n int) {
+	return queue.q.TryPopSome(out)
+}
+
 // Close puts the queue into a closed state. After closing the queue
 // all read methods on it become non-blocking and all write methods
 // on it will panic.
@@ -109,3 +143,26 @@ func (queue *BlockingBounded[T]) Close() {
 		panic(ErrCloseClosed)
 	}
 }
+
+// IsClosed returns true if the queue is closed.
+// Use [BlockingBounded.Closed] to wait for the queue to be closed.
+func (queue *BlockingBounded[T]) IsClosed() bool {
+	return queue.q.Closed()
+}
+
+// Cap returns the capacity of the queue.
+func (queue *BlockingBounded[T]) Cap() int {
+	return queue.q.Cap()
+}
+
+// Len returns the current number of elements in the queue. The value may be
+// outdated as soon as it's returned if the queue is used concurrently.
+func (queue *BlockingBounded[T]) Len() int {
+	return queue.q.Len()
+}
+
+// Available returns how much elements the queue is able to take. Similarly
+// to [BlockingBounded.Len], the value is only a snapshot.
+func (queue *BlockingBounded[T]) Available() int {
+	return queue.q.Available()
+}
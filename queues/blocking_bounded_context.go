@@ -0,0 +1,108 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"context"
+	"time"
+)
+
+// PushContext is similar to [BlockingBounded.Push] but stops waiting for a room
+// and returns ctx.Err() when ctx is canceled or deadlined.
+func (queue *BlockingBounded[T]) PushContext(ctx context.Context, elem T) error {
+	return queue.push(ctx, elem)
+}
+
+// PushSomeContext is similar to [BlockingBounded.PushSome] but stops waiting for a room
+// and returns ctx.Err() when ctx is canceled or deadlined.
+func (queue *BlockingBounded[T]) PushSomeContext(ctx context.Context, values []T) (n int, err error) {
+	if len(values) == 0 {
+		if queue.q.Closed() {
+			panic(ErrPushClosed)
+		}
+		return 0, nil
+	}
+	limit := queue.blockLimit(ctx)
+	defer limit.stop()
+	n, _, err = queue.pushSome(&limit, values)
+	return n, err
+}
+
+// PushAllContext is similar to [BlockingBounded.PushAll] but stops waiting for a room
+// and returns ctx.Err() when ctx is canceled or deadlined. Return value is the number
+// of slice elements added to the queue before that.
+func (queue *BlockingBounded[T]) PushAllContext(ctx context.Context, values []T) (n int, err error) {
+	// No push is really attempted so lets check that queue is closed manually.
+	if len(values) == 0 && queue.q.Closed() {
+		panic(ErrPushClosed)
+	}
+	// The block timeout limits the whole call rather than each chunk.
+	limit := queue.blockLimit(ctx)
+	defer limit.stop()
+	for consumed := 0; consumed < len(values); {
+		added, m, err := queue.pushSome(&limit, values[consumed:])
+		if err != nil {
+			return n, err
+		}
+		n += added
+		consumed += m
+	}
+	return n, nil
+}
+
+// PopContext is similar to [BlockingBounded.Pop] but stops waiting for an element
+// and returns ctx.Err() when ctx is canceled or deadlined.
+func (queue *BlockingBounded[T]) PopContext(ctx context.Context) (value T, ok bool, err error) {
+	return queue.q.PopContext(ctx)
+}
+
+// PopSomeContext is similar to [BlockingBounded.PopSome] but stops waiting for elements
+// and returns ctx.Err() when ctx is canceled or deadlined.
+func (queue *BlockingBounded[T]) PopSomeContext(ctx context.Context, out []T) (n int, err error) {
+	if len(out) == 0 {
+		return 0, nil
+	}
+	return queue.q.PopSomeNonEmptyContext(ctx, out)
+}
+
+// PushTimeout is similar to [BlockingBounded.PushContext] but waits
+// no longer than the timeout.
+func (queue *BlockingBounded[T]) PushTimeout(elem T, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PushContext(ctx, elem)
+}
+
+// PushSomeTimeout is similar to [BlockingBounded.PushSomeContext] but waits
+// no longer than the timeout.
+func (queue *BlockingBounded[T]) PushSomeTimeout(values []T, timeout time.Duration) (n int, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PushSomeContext(ctx, values)
+}
+
+// PushAllTimeout is similar to [BlockingBounded.PushAllContext] but waits
+// no longer than the timeout.
+func (queue *BlockingBounded[T]) PushAllTimeout(values []T, timeout time.Duration) (n int, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PushAllContext(ctx, values)
+}
+
+// PopTimeout is similar to [BlockingBounded.PopContext] but waits
+// no longer than the timeout.
+func (queue *BlockingBounded[T]) PopTimeout(timeout time.Duration) (value T, ok bool, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PopContext(ctx)
+}
+
+// PopSomeTimeout is similar to [BlockingBounded.PopSomeContext] but waits
+// no longer than the timeout.
+func (queue *BlockingBounded[T]) PopSomeTimeout(out []T, timeout time.Duration) (n int, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PopSomeContext(ctx, out)
+}
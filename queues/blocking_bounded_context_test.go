@@ -0,0 +1,111 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	internal "github.com/marshall-lee/dope/internal/tests"
+	"github.com/stretchr/testify/suite"
+)
+
+// BlockingBoundedContextTestSuite tests context-aware and timed methods.
+type BlockingBoundedContextTestSuite struct {
+	suite.Suite
+}
+
+func (s *BlockingBoundedContextTestSuite) TestPushContextCanceled() {
+	queue := NewBlockingBounded[int](1)
+	s.Require().NoError(queue.PushContext(context.Background(), 42))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	push := internal.GoCaptureWithReturnValue(func() error { return queue.PushContext(ctx, 43) })
+	s.Require().Never(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "PushContext unexpectedly returned")
+	cancel()
+	s.Require().Eventually(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "PushContext did not return as was expected")
+	s.Require().ErrorIs(push.Val(), context.Canceled)
+
+	val, ok := queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(42, val)
+}
+
+func (s *BlockingBoundedContextTestSuite) TestPushContextUnblocked() {
+	queue := NewBlockingBounded[int](1)
+	queue.Push(42)
+	push := internal.GoCaptureWithReturnValue(func() error { return queue.PushContext(context.Background(), 43) })
+	s.Require().Never(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "PushContext unexpectedly returned")
+	queue.Pop()
+	s.Require().Eventually(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "PushContext did not return as was expected")
+	s.Require().NoError(push.Val())
+}
+
+func (s *BlockingBoundedContextTestSuite) TestPushTimeout() {
+	queue := NewBlockingBounded[int](2)
+	n, err := queue.PushAllTimeout([]int{1, 2, 3}, 20*time.Millisecond)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Require().Equal(2, n)
+
+	n, err = queue.PushSomeTimeout([]int{3}, 20*time.Millisecond)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Require().Equal(0, n)
+
+	s.Require().ErrorIs(queue.PushTimeout(3, 20*time.Millisecond), context.DeadlineExceeded)
+}
+
+func (s *BlockingBoundedContextTestSuite) TestPopTimeout() {
+	queue := NewBlockingBounded[int](2)
+	_, ok, err := queue.PopTimeout(20 * time.Millisecond)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Require().False(ok)
+
+	n, err := queue.PopSomeTimeout(make([]int, 2), 20*time.Millisecond)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Require().Equal(0, n)
+
+	queue.Push(42)
+	val, ok, err := queue.PopTimeout(20 * time.Millisecond)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().Equal(42, val)
+}
+
+func (s *BlockingBoundedContextTestSuite) TestPopContextClosed() {
+	queue := NewBlockingBounded[int](2)
+	type popResult struct {
+		ok  bool
+		err error
+	}
+	pop := internal.GoCaptureWithReturnValue(func() popResult {
+		_, ok, err := queue.PopContext(context.Background())
+		return popResult{ok, err}
+	})
+	s.Require().Never(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "PopContext unexpectedly returned")
+	queue.Close()
+	s.Require().Eventually(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "PopContext did not return as was expected")
+	s.Require().False(pop.Val().ok)
+	s.Require().NoError(pop.Val().err)
+
+	n, err := queue.PopSomeContext(context.Background(), make([]int, 2))
+	s.Require().NoError(err)
+	s.Require().Equal(0, n)
+}
+
+func (s *BlockingBoundedContextTestSuite) TestPushContextToAClosed() {
+	queue := NewBlockingBounded[int](1)
+	queue.Push(42)
+	push := internal.GoCapture(func() { queue.PushContext(context.Background(), 43) })
+	s.Require().Never(push.IsPanicked, 100*time.Millisecond, 10*time.Millisecond, "PushContext panicked unexpectedly")
+	queue.Close()
+	s.Require().Eventually(push.IsPanicked, 100*time.Millisecond, 10*time.Millisecond, "PushContext did not panic as was expected")
+	s.Require().Equal(ErrPushClosed, push.Err())
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.PushAllContext(context.Background(), nil) })
+}
+
+func TestBlockingBoundedContext(t *testing.T) {
+	suite.Run(t, new(BlockingBoundedContextTestSuite))
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"context"
+	"time"
+)
+
+// Dropped returns the number of elements dropped according to
+// the overflow policy.
+func (queue *BlockingBounded[T]) Dropped() uint64 {
+	return queue.dropped.Load()
+}
+
+// push adds an element to the queue according to the overflow policy.
+// Return value is ctx.Err() if ctx is done before the element is either
+// added or dropped.
+func (queue *BlockingBounded[T]) push(ctx context.Context, elem T) error {
+	limit := queue.blockLimit(ctx)
+	defer limit.stop()
+	return queue.pushLimited(&limit, elem)
+}
+
+// pushLimited is similar to push but waits for a room within the limit.
+func (queue *BlockingBounded[T]) pushLimited(limit *blockLimit, elem T) error {
+	ctx := limit.ctx
+	switch queue.overflow {
+	case OverflowDropNewest:
+		pushed, ok := queue.q.TryPush(elem)
+		if !ok {
+			panic(ErrPushClosed)
+		}
+		if !pushed {
+			queue.dropped.Add(1)
+		}
+		return nil
+	case OverflowDropOldest:
+		overwritten, ok := queue.q.PushOverwrite(elem)
+		if !ok {
+			panic(ErrPushClosed)
+		}
+		if overwritten {
+			queue.dropped.Add(1)
+		}
+		return nil
+	}
+
+	if queue.blockTimeout > 0 {
+		// Avoid making a timer unless the call would block.
+		pushed, ok := queue.q.TryPush(elem)
+		if !ok {
+			panic(ErrPushClosed)
+		}
+		if pushed {
+			return nil
+		}
+	}
+	ok, err := queue.q.PushContext(limit.context(), elem)
+	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		queue.dropped.Add(1)
+		return nil
+	}
+	if !ok {
+		panic(ErrPushClosed)
+	}
+	return nil
+}
+
+// pushSome adds some of non-empty slice elements to the queue according to
+// the overflow policy. Return value n is the number of elements added to the
+// queue and m is the number of elements consumed from the slice, i.e. either
+// added or dropped. Return value err is ctx.Err() if ctx is done before
+// any element is consumed. The limit of waiting for a room is shared by
+// all the calls made with it, e.g. by all the chunks of a single PushAll.
+func (queue *BlockingBounded[T]) pushSome(limit *blockLimit, values []T) (n, m int, err error) {
+	ctx := limit.ctx
+	switch queue.overflow {
+	case OverflowDropNewest:
+		n, ok := queue.q.TryPushSome(values)
+		if !ok {
+			panic(ErrPushClosed)
+		}
+		queue.dropped.Add(uint64(len(values) - n))
+		return n, len(values), nil
+	case OverflowDropOldest:
+		overwritten, ok := queue.q.PushAllOverwrite(values)
+		if !ok {
+			panic(ErrPushClosed)
+		}
+		queue.dropped.Add(uint64(overwritten))
+		return len(values), len(values), nil
+	}
+
+	if queue.blockTimeout > 0 {
+		// Avoid making a timer unless the call would block.
+		n, ok := queue.q.TryPushSome(values)
+		if !ok {
+			panic(ErrPushClosed)
+		}
+		if n > 0 {
+			return n, n, nil
+		}
+	}
+	n, err = queue.q.PushSomeNonEmptyContext(limit.context(), values)
+	if err != nil {
+		if ctx.Err() != nil {
+			return 0, 0, err
+		}
+		queue.dropped.Add(uint64(len(values)))
+		return 0, len(values), nil
+	}
+	if n == 0 {
+		panic(ErrPushClosed)
+	}
+	return n, n, nil
+}
+
+// blockLimit limits the time of waiting for a room by the block timeout.
+// The deadline is set on the first wait, so a call that doesn't block
+// doesn't make a timer.
+type blockLimit struct {
+	ctx     context.Context
+	timeout time.Duration
+	limited context.Context
+	cancel  context.CancelFunc
+}
+
+func (queue *BlockingBounded[T]) blockLimit(ctx context.Context) blockLimit {
+	return blockLimit{ctx: ctx, timeout: queue.blockTimeout}
+}
+
+// context returns a context of waiting for a room.
+func (limit *blockLimit) context() context.Context {
+	if limit.timeout == 0 {
+		return limit.ctx
+	}
+	if limit.limited == nil {
+		limit.limited, limit.cancel = context.WithTimeout(limit.ctx, limit.timeout)
+	}
+	return limit.limited
+}
+
+// stop releases the resources associated with the limit.
+func (limit *blockLimit) stop() {
+	if limit.cancel != nil {
+		limit.cancel()
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+// Readable returns a channel that is closed once the queue becomes readable,
+// i.e. either non-empty or closed, so that waiting for elements can be
+// combined with other channels in a select statement. If the queue is readable
+// already, the returned channel is closed.
+//
+// The notification is edge-triggered: every channel fires once and has to be
+// obtained again after that. Since a concurrent reader may consume elements
+// first, readiness is only a hint, so it's meant to be followed by
+// [BlockingBounded.TryPop] or [BlockingBounded.TryPopSome]:
+//
+//	for {
+//		select {
+//		case <-queue.Readable():
+//			for value, ok := queue.TryPop(); ok; value, ok = queue.TryPop() {
+//				// ...
+//			}
+//		case <-ticker.C:
+//			// ...
+//		}
+//	}
+func (queue *BlockingBounded[T]) Readable() <-chan struct{} {
+	return queue.q.Readable()
+}
+
+// Writable returns a channel that is closed once the queue becomes writable,
+// i.e. either non-full or closed. If the queue is writable already, the
+// returned channel is closed. Similarly to [BlockingBounded.Readable],
+// the notification is edge-triggered and is meant to be followed by
+// [BlockingBounded.TryPush] or [BlockingBounded.TryPushSome].
+//
+// Note that a closed queue is reported as writable because pushing to it
+// doesn't block but panics instead.
+func (queue *BlockingBounded[T]) Writable() <-chan struct{} {
+	return queue.q.Writable()
+}
+
+// Closed returns a channel that is closed when the queue is closed.
+func (queue *BlockingBounded[T]) Closed() <-chan struct{} {
+	return queue.q.Done()
+}
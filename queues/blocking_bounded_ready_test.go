@@ -0,0 +1,129 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// BlockingBoundedReadyTestSuite tests readiness channels.
+type BlockingBoundedReadyTestSuite struct {
+	suite.Suite
+}
+
+const readyTimeout = 100 * time.Millisecond
+
+func (s *BlockingBoundedReadyTestSuite) requireFired(ch <-chan struct{}) {
+	s.T().Helper()
+	select {
+	case <-ch:
+	case <-time.After(readyTimeout):
+		s.FailNow("channel did not fire as was expected")
+	}
+}
+
+func (s *BlockingBoundedReadyTestSuite) requireNotFired(ch <-chan struct{}) {
+	s.T().Helper()
+	select {
+	case <-ch:
+		s.FailNow("channel fired unexpectedly")
+	case <-time.After(readyTimeout / 4):
+	}
+}
+
+func (s *BlockingBoundedReadyTestSuite) TestReadable() {
+	queue := NewBlockingBounded[int](2)
+	readable := queue.Readable()
+	s.requireNotFired(readable)
+
+	queue.Push(42)
+	s.requireFired(readable)
+	s.requireFired(queue.Readable())
+
+	queue.Pop()
+	s.requireNotFired(queue.Readable())
+}
+
+func (s *BlockingBoundedReadyTestSuite) TestWritable() {
+	queue := NewBlockingBounded[int](1)
+	s.requireFired(queue.Writable())
+
+	queue.Push(42)
+	writable := queue.Writable()
+	s.requireNotFired(writable)
+
+	queue.Pop()
+	s.requireFired(writable)
+}
+
+func (s *BlockingBoundedReadyTestSuite) TestClose() {
+	queue := NewBlockingBounded[int](1)
+	queue.Push(42)
+	readable, writable, done := queue.Readable(), queue.Writable(), queue.Closed()
+	s.requireFired(readable)
+	s.requireNotFired(writable)
+	s.requireNotFired(done)
+
+	queue.Pop()
+	s.requireFired(writable)
+	readable = queue.Readable()
+	s.requireNotFired(readable)
+
+	queue.Close()
+	s.requireFired(readable)
+	s.requireFired(done)
+	s.requireFired(queue.Readable())
+	s.requireFired(queue.Writable())
+}
+
+func (s *BlockingBoundedReadyTestSuite) TestSelect() {
+	a, b := NewBlockingBounded[int](4), NewBlockingBounded[int](4)
+	go func() {
+		for i := 1; i <= 10; i++ {
+			if i%2 == 0 {
+				a.Push(i)
+			} else {
+				b.Push(i)
+			}
+		}
+		a.Close()
+		b.Close()
+	}()
+
+	var sum int
+	for a, b := a, b; a != nil || b != nil; {
+		var aReadable, bReadable <-chan struct{}
+		if a != nil {
+			aReadable = a.Readable()
+		}
+		if b != nil {
+			bReadable = b.Readable()
+		}
+		select {
+		case <-aReadable:
+			if value, ok := a.TryPop(); ok {
+				sum += value
+			} else if a.IsClosed() {
+				a = nil
+			}
+		case <-bReadable:
+			if value, ok := b.TryPop(); ok {
+				sum += value
+			} else if b.IsClosed() {
+				b = nil
+			}
+		case <-time.After(readyTimeout):
+			s.FailNow("select did not return as was expected")
+		}
+	}
+	s.Require().Equal(55, sum)
+}
+
+func TestBlockingBoundedReady(t *testing.T) {
+	suite.Run(t, new(BlockingBoundedReadyTestSuite))
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// BlockingBoundedTryTestSuite tests non-blocking methods.
+type BlockingBoundedTryTestSuite struct {
+	suite.Suite
+}
+
+func (s *BlockingBoundedTryTestSuite) TestTryPush() {
+	queue := NewBlockingBounded[int](2)
+	s.Require().True(queue.TryPush(1))
+	s.Require().True(queue.TryPush(2))
+	s.Require().False(queue.TryPush(3))
+	s.Require().Equal(2, queue.Len())
+	s.Require().Equal(0, queue.Available())
+}
+
+func (s *BlockingBoundedTryTestSuite) TestTryPushSome() {
+	queue := NewBlockingBounded[int](3)
+	s.Require().Equal(0, queue.TryPushSome(nil))
+	s.Require().Equal(2, queue.TryPushSome([]int{1, 2}))
+	s.Require().Equal(1, queue.TryPushSome([]int{3, 4}))
+	s.Require().Equal(0, queue.TryPushSome([]int{4}))
+
+	var out [4]int
+	s.Require().Equal(3, queue.TryPopSome(out[:]))
+	s.Require().Equal([]int{1, 2, 3}, out[:3])
+}
+
+func (s *BlockingBoundedTryTestSuite) TestTryPop() {
+	queue := NewBlockingBounded[int](2)
+	_, ok := queue.TryPop()
+	s.Require().False(ok)
+
+	queue.Push(42)
+	val, ok := queue.TryPop()
+	s.Require().True(ok)
+	s.Require().Equal(42, val)
+
+	_, ok = queue.TryPop()
+	s.Require().False(ok)
+}
+
+func (s *BlockingBoundedTryTestSuite) TestTryPopSomeEmpty() {
+	queue := NewBlockingBounded[int](2)
+	var out [2]int
+	s.Require().Equal(0, queue.TryPopSome(out[:]))
+	queue.Push(42)
+	s.Require().Equal(0, queue.TryPopSome(nil))
+	s.Require().Equal(1, queue.TryPopSome(out[:]))
+}
+
+func (s *BlockingBoundedTryTestSuite) TestWraparound() {
+	queue := NewBlockingBounded[int](3)
+	var out [2]int
+	for i := 0; i < 10; i++ {
+		s.Require().Equal(2, queue.TryPushSome([]int{2 * i, 2*i + 1}))
+		s.Require().Equal(2, queue.TryPopSome(out[:]))
+		s.Require().Equal([2]int{2 * i, 2*i + 1}, out)
+	}
+}
+
+func (s *BlockingBoundedTryTestSuite) TestIntrospection() {
+	queue := NewBlockingBounded[int](4)
+	s.Require().Equal(4, queue.Cap())
+	s.Require().Equal(0, queue.Len())
+	s.Require().Equal(4, queue.Available())
+	s.Require().False(queue.IsClosed())
+
+	queue.PushAll([]int{1, 2, 3})
+	s.Require().Equal(4, queue.Cap())
+	s.Require().Equal(3, queue.Len())
+	s.Require().Equal(1, queue.Available())
+
+	queue.Close()
+	s.Require().True(queue.IsClosed())
+}
+
+func (s *BlockingBoundedTryTestSuite) TestClosed() {
+	queue := NewBlockingBounded[int](2)
+	queue.Push(42)
+	queue.Close()
+
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.TryPush(43) })
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.TryPushSome([]int{43}) })
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.TryPushSome(nil) })
+
+	// Remaining elements are still consumable after closing.
+	val, ok := queue.TryPop()
+	s.Require().True(ok)
+	s.Require().Equal(42, val)
+
+	_, ok = queue.TryPop()
+	s.Require().False(ok)
+	var out [2]int
+	s.Require().Equal(0, queue.TryPopSome(out[:]))
+}
+
+func (s *BlockingBoundedTryTestSuite) TestClosedFull() {
+	queue := NewBlockingBounded[int](1)
+	queue.Push(42)
+	queue.Close()
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.TryPush(43) })
+	s.Require().Equal(1, queue.Len())
+}
+
+func TestBlockingBoundedTry(t *testing.T) {
+	suite.Run(t, new(BlockingBoundedTryTestSuite))
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"fmt"
+
+	internal "github.com/marshall-lee/dope/internal/queues"
+)
+
+// BlockingPriority is a blocking priority queue of a fixed capacity.
+// It's ordered in the same way as [Priority] does and is designed
+// for a producer-consumer use case similarly to [BlockingBounded].
+//
+// When the queue is in an open state and empty, all read methods block until
+// it either becomes non-empty or closed.
+// When the queue is in a closed state, all read methods return immediately i.e.
+// they are are non-blocking.
+//
+// And when the queue is in an open state and full, all write methods block
+// until it becomes either partially full or empty.
+// Writing to a closed queue results in panic.
+type BlockingPriority[T any] struct {
+	q internal.BlockingPriority[T]
+}
+
+// NewBlockingPriority makes a new blocking priority queue with a given capacity
+// ordered by the less function. Capacity is expected to be a positive non-zero
+// integer value, otherwise this function panics.
+func NewBlockingPriority[T any](cap int, less func(a, b T) bool) *BlockingPriority[T] {
+	if cap <= 0 {
+		panic(fmt.Errorf("queues: capacity must be a positive integer value but %v is given", cap))
+	}
+	if less == nil {
+		panic(errNilLess)
+	}
+
+	var queue BlockingPriority[T]
+	queue.q.Init(less, cap)
+	return &queue
+}
+
+// Push adds an element to the queue. This method blocks while the queue
+// is full and panics if the queue is closed.
+func (queue *BlockingPriority[T]) Push(elem T) {
+	if ok := queue.q.Push(elem); !ok {
+		panic(ErrPushClosed)
+	}
+}
+
+// PushSome adds to the queue at least some of the slice elements. This method
+// blocks while the queue is full and panics if the queue is closed. Return value is the number of slice
+// elements added to the queue and it's always non-zero.
+func (queue *BlockingPriority[T]) PushSome(values []T) (n int) {
+	if len(values) == 0 {
+		if queue.q.Closed() {
+			panic(ErrPushClosed)
+		}
+		return 0
+	}
+	if n = queue.q.PushSomeNonEmpty(values); n == 0 {
+		panic(ErrPushClosed)
+	}
+	return n
+}
+
+// PushAll adds to the queue all the elements from the slice. This method blocks until
+// eventually the room is found for every element of the slice. If in the process of pushing elements
+// to the queue it was closed, this method panics.
+func (queue *BlockingPriority[T]) PushAll(values []T) {
+	// No push is really attempted so lets check that queue is closed manually.
+	if len(values) == 0 && queue.q.Closed() {
+		panic(ErrPushClosed)
+	}
+	for len(values) != 0 {
+		m := queue.q.PushSomeNonEmpty(values)
+		if m == 0 {
+			panic(ErrPushClosed)
+		}
+		values = values[m:]
+	}
+}
+
+// Pop attempts to consume the least element from the queue. This method blocks while the queue
+// is empty and returns immediately if the queue is closed.
+func (queue *BlockingPriority[T]) Pop() (value T, ok bool) {
+	return queue.q.Pop()
+}
+
+// PopSome attempts to consume a bunch of the least elements from the queue. This method blocks
+// while the queue is empty and returns immediately if the queue is closed. Return value is the
+// number of elements consumed from the queue.
+func (queue *BlockingPriority[T]) PopSome(out []T) (n int) {
+	if len(out) == 0 {
+		return 0
+	}
+	return queue.q.PopSomeNonEmpty(out)
+}
+
+// TryPush attempts to add an element to the queue without blocking. Return value
+// is false if the queue is full. Like [BlockingPriority.Push], it panics if the
+// queue is closed.
+func (queue *BlockingPriority[T]) TryPush(elem T) (ok bool) {
+	pushed, ok := queue.q.TryPush(elem)
+	if !ok {
+		panic(ErrPushClosed)
+	}
+	return pushed
+}
+
+// TryPushSome attempts to add to the queue some of the slice elements without
+// blocking. Return value is the number of slice elements added to the queue.
+// Zero means that the queue is full. Like [BlockingPriority.PushSome], it panics
+// if the queue is closed.
+func (queue *BlockingPriority[T]) TryPushSome(values []T) (n int) {
+	n, ok := queue.q.TryPushSome(values)
+	if !ok {
+		panic(ErrPushClosed)
+	}
+	return n
+}
+
+// TryPop attempts to consume the least element from the queue without blocking.
+// Return value ok is false if the queue is empty, regardless of whether
+// it's closed or not.
+func (queue *BlockingPriority[T]) TryPop() (value T, ok bool) {
+	return queue.q.TryPop()
+}
+
+// TryPopSome attempts to consume a bunch of the least elements from the queue
+// without blocking. Return value is the number of elements consumed from the queue.
+func (queue *BlockingPriority[T]) TryPopSome(out []T) (n int) {
+	return queue.q.TryPopSome(out)
+}
+
+// Cap returns the capacity of the queue.
+func (queue *BlockingPriority[T]) Cap() int {
+	return queue.q.Cap()
+}
+
+// Len returns the current number of elements in the queue.
+func (queue *BlockingPriority[T]) Len() int {
+	return queue.q.Len()
+}
+
+// Close puts the queue into a closed state. After closing the queue
+// all read methods on it become non-blocking and all write methods
+// on it will panic.
+// Calling this method twice on the same queue also results in panic.
+func (queue *BlockingPriority[T]) Close() {
+	if ok := queue.q.Close(); !ok {
+		panic(ErrCloseClosed)
+	}
+}
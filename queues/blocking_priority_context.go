@@ -0,0 +1,115 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"context"
+	"time"
+)
+
+// PushContext is similar to [BlockingPriority.Push] but stops waiting for a room
+// and returns ctx.Err() when ctx is canceled or deadlined.
+func (queue *BlockingPriority[T]) PushContext(ctx context.Context, elem T) error {
+	ok, err := queue.q.PushContext(ctx, elem)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		panic(ErrPushClosed)
+	}
+	return nil
+}
+
+// PushSomeContext is similar to [BlockingPriority.PushSome] but stops waiting for a room
+// and returns ctx.Err() when ctx is canceled or deadlined.
+func (queue *BlockingPriority[T]) PushSomeContext(ctx context.Context, values []T) (n int, err error) {
+	if len(values) == 0 {
+		if queue.q.Closed() {
+			panic(ErrPushClosed)
+		}
+		return 0, nil
+	}
+	n, err = queue.q.PushSomeNonEmptyContext(ctx, values)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		panic(ErrPushClosed)
+	}
+	return n, nil
+}
+
+// PushAllContext is similar to [BlockingPriority.PushAll] but stops waiting for a room
+// and returns ctx.Err() when ctx is canceled or deadlined. Return value is the number
+// of slice elements added to the queue before that.
+func (queue *BlockingPriority[T]) PushAllContext(ctx context.Context, values []T) (n int, err error) {
+	// No push is really attempted so lets check that queue is closed manually.
+	if len(values) == 0 && queue.q.Closed() {
+		panic(ErrPushClosed)
+	}
+	for n < len(values) {
+		m, err := queue.PushSomeContext(ctx, values[n:])
+		if err != nil {
+			return n, err
+		}
+		n += m
+	}
+	return n, nil
+}
+
+// PopContext is similar to [BlockingPriority.Pop] but stops waiting for an element
+// and returns ctx.Err() when ctx is canceled or deadlined.
+func (queue *BlockingPriority[T]) PopContext(ctx context.Context) (value T, ok bool, err error) {
+	return queue.q.PopContext(ctx)
+}
+
+// PopSomeContext is similar to [BlockingPriority.PopSome] but stops waiting for elements
+// and returns ctx.Err() when ctx is canceled or deadlined.
+func (queue *BlockingPriority[T]) PopSomeContext(ctx context.Context, out []T) (n int, err error) {
+	if len(out) == 0 {
+		return 0, nil
+	}
+	return queue.q.PopSomeNonEmptyContext(ctx, out)
+}
+
+// PushTimeout is similar to [BlockingPriority.PushContext] but waits
+// no longer than the timeout.
+func (queue *BlockingPriority[T]) PushTimeout(elem T, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PushContext(ctx, elem)
+}
+
+// PushSomeTimeout is similar to [BlockingPriority.PushSomeContext] but waits
+// no longer than the timeout.
+func (queue *BlockingPriority[T]) PushSomeTimeout(values []T, timeout time.Duration) (n int, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PushSomeContext(ctx, values)
+}
+
+// PushAllTimeout is similar to [BlockingPriority.PushAllContext] but waits
+// no longer than the timeout.
+func (queue *BlockingPriority[T]) PushAllTimeout(values []T, timeout time.Duration) (n int, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PushAllContext(ctx, values)
+}
+
+// PopTimeout is similar to [BlockingPriority.PopContext] but waits
+// no longer than the timeout.
+func (queue *BlockingPriority[T]) PopTimeout(timeout time.Duration) (value T, ok bool, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PopContext(ctx)
+}
+
+// PopSomeTimeout is similar to [BlockingPriority.PopSomeContext] but waits
+// no longer than the timeout.
+func (queue *BlockingPriority[T]) PopSomeTimeout(out []T, timeout time.Duration) (n int, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return queue.PopSomeContext(ctx, out)
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"fmt"
+
+	internal "github.com/marshall-lee/dope/internal/queues"
+	"github.com/marshall-lee/dope/opt"
+)
+
+// BlockingUnbounded is a blocking FIFO queue that grows as needed.
+// Internally, it's implemented as a synchronized growable ring buffer
+// and is designed for a producer-consumer use case where producers
+// never block.
+//
+// When the queue is in an open state and empty, all read methods block until
+// it either becomes non-empty or closed.
+// When the queue is in a closed state, all read methods return immediately i.e.
+// they are are non-blocking.
+//
+// Write methods never block. The memory consumption can be limited
+// using the [WithHighWater] option: write methods then reject the
+// elements that exceed the limit.
+// Writing to a closed queue results in panic.
+type BlockingUnbounded[T any] struct {
+	q internal.BlockingUnbounded[T]
+}
+
+// NewBlockingUnbounded makes a new blocking FIFO queue.
+// It respects the [Options.HighWater] option which is expected to be
+// a non-negative integer value, otherwise this function panics.
+// It also panics if any other option is given.
+func NewBlockingUnbounded[T any](setters ...opt.Setter[Options]) *BlockingUnbounded[T] {
+	var opts Options
+	opt.Apply(&opts, setters...)
+	validateSupported(&opts, "BlockingUnbounded", optionHighWater)
+	if opts.HighWater < 0 {
+		panic(fmt.Errorf("queues: high water must be a non-negative integer value but %v is given", opts.HighWater))
+	}
+
+	var queue BlockingUnbounded[T]
+	queue.q.Init(opts.HighWater)
+	return &queue
+}
+
+// Push adds an element to the queue. Return value is false if the element
+// was rejected because the queue has reached its high-water limit.
+// This method panics if the queue is closed.
+func (queue *BlockingUnbounded[T]) Push(elem T) (ok bool) {
+	pushed, ok := queue.q.Push(elem)
+	if !ok {
+		panic(ErrPushClosed)
+	}
+	return pushed
+}
+
+// PushSome adds to the queue the elements from the slice. Return value
+// is the number of elements added to the queue which is less than the length
+// of the slice only if the queue has reached its high-water limit.
+// This method panics if the queue is closed.
+func (queue *BlockingUnbounded[T]) PushSome(values []T) (n int) {
+	n, ok := queue.q.PushSome(values)
+	if !ok {
+		panic(ErrPushClosed)
+	}
+	return n
+}
+
+// Pop attempts to consume one element from the queue. This method blocks while the queue is empty
+// and returns immediately if the queue is closed.
+func (queue *BlockingUnbounded[T]) Pop() (value T, ok bool) {
+	return queue.q.Pop()
+}
+
+// PopSome attempts to consume a bunch of elements from the queue. This method blocks while the
+// queue is empty and returns immediately if the queue is closed. Return value is the number of
+// elements consumed from the queue.
+func (queue *BlockingUnbounded[T]) PopSome(out []T) (n int) {
+	if len(out) == 0 {
+		return 0
+	}
+	return queue.q.PopSomeNonEmpty(out)
+}
+
+// Len returns the current number of elements in the queue.
+func (queue *BlockingUnbounded[T]) Len() int {
+	return queue.q.Len()
+}
+
+// Close puts the queue into a closed state. After closing the queue
+// all read methods on it become non-blocking and all write methods
+// on it will panic.
+// Calling this method twice on the same queue also results in panic.
+func (queue *BlockingUnbounded[T]) Close() {
+	if ok := queue.q.Close(); !ok {
+		panic(ErrCloseClosed)
+	}
+}
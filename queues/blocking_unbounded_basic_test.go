@@ -0,0 +1,104 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"testing"
+	"time"
+
+	internal "github.com/marshall-lee/dope/internal/tests"
+	"github.com/stretchr/testify/suite"
+)
+
+// BlockingUnboundedBasicTestSuite tests Push/Pop methods.
+type BlockingUnboundedBasicTestSuite struct {
+	suite.Suite
+}
+
+func (s *BlockingUnboundedBasicTestSuite) TestNegativeHighWaterPanic() {
+	s.Require().PanicsWithError("queues: high water must be a non-negative integer value but -1 is given", func() { NewBlockingUnbounded[int](WithHighWater(-1)) })
+}
+
+func (s *BlockingUnboundedBasicTestSuite) TestPushPop() {
+	queue := NewBlockingUnbounded[int]()
+	for i := 0; i < 100; i++ {
+		s.Require().True(queue.Push(i))
+	}
+	s.Require().Equal(100, queue.Len())
+	for i := 0; i < 100; i++ {
+		val, ok := queue.Pop()
+		s.Require().True(ok)
+		s.Require().Equal(i, val)
+	}
+}
+
+func (s *BlockingUnboundedBasicTestSuite) TestEmptyPopAndPush() {
+	queue := NewBlockingUnbounded[int]()
+	pop := internal.GoCaptureWithReturnValue(func() int { val, _ := queue.Pop(); return val })
+	s.Require().Never(pop.IsDone, 200*time.Millisecond, 10*time.Millisecond, "Pop unexpectedly returned")
+	queue.Push(42)
+	s.Require().Eventually(pop.IsDone, 200*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().Equal(42, pop.Val())
+}
+
+func (s *BlockingUnboundedBasicTestSuite) TestEmptyPopSomeAndClose() {
+	queue := NewBlockingUnbounded[int]()
+	pop := internal.GoCaptureWithReturnValue(func() int { return queue.PopSome(make([]int, 4)) })
+	s.Require().Never(pop.IsDone, 200*time.Millisecond, 10*time.Millisecond, "PopSome unexpectedly returned")
+	queue.Close()
+	s.Require().Eventually(pop.IsDone, 200*time.Millisecond, 10*time.Millisecond, "PopSome did not return as was expected")
+	s.Require().Equal(0, pop.Val())
+}
+
+func (s *BlockingUnboundedBasicTestSuite) TestPushSomePopSomeAndClose() {
+	queue := NewBlockingUnbounded[int]()
+	s.Require().Equal(3, queue.PushSome([]int{41, 42, 43}))
+	queue.Close()
+
+	out := make([]int, 2)
+	s.Require().Equal(2, queue.PopSome(out))
+	s.Require().Equal([]int{41, 42}, out)
+	s.Require().Equal(1, queue.PopSome(out))
+	s.Require().Equal(43, out[0])
+	s.Require().Equal(0, queue.PopSome(out))
+	_, ok := queue.Pop()
+	s.Require().False(ok)
+}
+
+func (s *BlockingUnboundedBasicTestSuite) TestHighWater() {
+	queue := NewBlockingUnbounded[int](WithHighWater(3))
+	s.Require().True(queue.Push(1))
+	s.Require().Equal(1, queue.PushSome([]int{2}))
+	s.Require().Equal(1, queue.PushSome([]int{3, 4, 5}))
+	s.Require().False(queue.Push(6))
+	s.Require().Equal(0, queue.PushSome([]int{7}))
+
+	val, ok := queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(1, val)
+	s.Require().True(queue.Push(8))
+
+	out := make([]int, 4)
+	s.Require().Equal(3, queue.PopSome(out))
+	s.Require().Equal([]int{2, 3, 8}, out[:3])
+}
+
+func (s *BlockingUnboundedBasicTestSuite) TestCloseOfAClosed() {
+	queue := NewBlockingUnbounded[int]()
+	queue.Close()
+	s.Require().PanicsWithValue(ErrCloseClosed, func() { queue.Close() }, "Close did not panic as was expected")
+}
+
+func (s *BlockingUnboundedBasicTestSuite) TestPushToAClosed() {
+	queue := NewBlockingUnbounded[int]()
+	queue.Close()
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.Push(42) }, "Push did not panic as was expected")
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.PushSome([]int{42}) }, "PushSome did not panic as was expected")
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.PushSome(nil) }, "PushSome did not panic as was expected")
+}
+
+func TestBlockingUnboundedBasic(t *testing.T) {
+	suite.Run(t, new(BlockingUnboundedBasicTestSuite))
+}
@@ -8,27 +8,38 @@ import (
 	"fmt"
 
 	internal "github.com/marshall-lee/dope/internal/queues"
+	"github.com/marshall-lee/dope/opt"
 )
 
 // Bounded is a FIFO queue of a fixed capacity.
 // Internally, it's implemented as a simple ring buffer represented by a
 // pre-allocated slice with two offsets.
 // All methods are non-blocking and this queue is not suitable for usage by multiple goroutines.
+//
+// By default, write methods reject the elements that don't fit into the queue.
+// This behaviour can be changed using the [WithOverflow] option.
 type Bounded[T any] struct {
-	q internal.Bounded[T]
+	q        internal.Bounded[T]
+	overflow OverflowPolicy
+	dropped  uint64
 }
 
 // NewBounded makes a new FIFO queue with a given capacity.
 // The buffer is allocated here and is never reallocated.
 //
 // Capacity is expected to be a positive non-zero integer value, otherwise
-// this function panics.
-func NewBounded[T any](cap int) *Bounded[T] {
+// this function panics. It respects the [Options.Overflow] option and
+// panics if any other option is given.
+func NewBounded[T any](cap int, setters ...opt.Setter[Options]) *Bounded[T] {
 	if cap <= 0 {
 		panic(fmt.Errorf("queues: capacity must be a positive integer value but %v is given", cap))
 	}
+	var opts Options
+	opt.Apply(&opts, setters...)
+	validateSupported(&opts, "Bounded", optionOverflow)
+	validateOverflow(&opts)
 
-	var queue Bounded[T]
+	queue := Bounded[T]{overflow: opts.Overflow}
 	queue.q.Init(cap)
 	return &queue
 }
@@ -36,15 +47,46 @@ func NewBounded[T any](cap int) *Bounded[T] {
 // Push attemtps to add an element to the queue. Return value is true
 // if there was a room to add a new element. If the queue is full,
 // then return value is false.
+//
+// With the [OverflowDropNewest] policy the rejected element is also counted
+// as dropped. With the [OverflowDropOldest] policy the oldest element is
+// overwritten instead, so return value is always true.
 func (queue *Bounded[T]) Push(elem T) (ok bool) {
-	return queue.q.Push(elem)
+	if queue.overflow == OverflowDropOldest {
+		if queue.q.PushOverwrite(elem) {
+			queue.dropped += 1
+		}
+		return true
+	}
+	if ok = queue.q.Push(elem); !ok && queue.overflow == OverflowDropNewest {
+		queue.dropped += 1
+	}
+	return ok
 }
 
 // PushSome attemtps to add to the queue at least some of the slice elements.
 // Return value is the number of slice elements added to the queue. Zero means
 // that the queue is full.
+//
+// With the [OverflowDropNewest] policy the rejected elements are also counted
+// as dropped. With the [OverflowDropOldest] policy the oldest elements are
+// overwritten instead, so all the slice elements are added.
 func (queue *Bounded[T]) PushSome(values []T) (n int) {
-	return queue.q.PushSome(values)
+	if queue.overflow == OverflowDropOldest {
+		queue.dropped += uint64(queue.q.PushAllOverwrite(values))
+		return len(values)
+	}
+	n = queue.q.PushSome(values)
+	if queue.overflow == OverflowDropNewest {
+		queue.dropped += uint64(len(values) - n)
+	}
+	return n
+}
+
+// Dropped returns the number of elements dropped according to
+// the overflow policy.
+func (queue *Bounded[T]) Dropped() uint64 {
+	return queue.dropped
 }
 
 // Pop attempts to consume one element from the queue. Return value ok is true
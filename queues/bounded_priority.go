@@ -0,0 +1,88 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"fmt"
+
+	internal "github.com/marshall-lee/dope/internal/queues"
+)
+
+// BoundedPriority is a priority queue of a fixed capacity.
+// It's ordered in the same way as [Priority] does.
+// All methods are non-blocking and this queue is not suitable for usage by multiple goroutines.
+type BoundedPriority[T any] struct {
+	q internal.Priority[T]
+}
+
+// NewBoundedPriority makes a new priority queue with a given capacity
+// ordered by the less function. The buffer is allocated here and is never reallocated.
+//
+// Capacity is expected to be a positive non-zero integer value, otherwise
+// this function panics.
+func NewBoundedPriority[T any](cap int, less func(a, b T) bool) *BoundedPriority[T] {
+	if cap <= 0 {
+		panic(fmt.Errorf("queues: capacity must be a positive integer value but %v is given", cap))
+	}
+	if less == nil {
+		panic(errNilLess)
+	}
+
+	var queue BoundedPriority[T]
+	queue.q.Init(less, cap)
+	return &queue
+}
+
+// Push attemtps to add an element to the queue. Return value is true
+// if there was a room to add a new element. If the queue is full,
+// then return value is false.
+func (queue *BoundedPriority[T]) Push(elem T) (ok bool) {
+	return queue.q.Push(elem)
+}
+
+// PushSome attemtps to add to the queue at least some of the slice elements.
+// Return value is the number of slice elements added to the queue. Zero means
+// that the queue is full.
+func (queue *BoundedPriority[T]) PushSome(values []T) (n int) {
+	return queue.q.PushSome(values)
+}
+
+// Pop attempts to consume the least element from the queue. Return value ok is true
+// whenever the element is successfully consumed. Otherwise, return value ok is
+// false and it basically means that the queue is empty.
+func (queue *BoundedPriority[T]) Pop() (value T, ok bool) {
+	return queue.q.Pop()
+}
+
+// PopSome attempts to consume a bunch of the least elements from the queue.
+// Return value is the number of elements consumed from it.
+func (queue *BoundedPriority[T]) PopSome(out []T) (n int) {
+	return queue.q.PopSome(out)
+}
+
+// Cap returns the capacity of the queue.
+func (queue *BoundedPriority[T]) Cap() int {
+	return queue.q.Cap()
+}
+
+// Len returns the current number of elements in the queue.
+func (queue *BoundedPriority[T]) Len() int {
+	return queue.q.Len()
+}
+
+// Available returns how much elements the queue is able to take.
+func (queue *BoundedPriority[T]) Available() int {
+	return queue.q.Available()
+}
+
+// Full returns true if the queue is full.
+func (queue *BoundedPriority[T]) Full() bool {
+	return queue.q.Full()
+}
+
+// Empty returns true if the queue is empty.
+func (queue *BoundedPriority[T]) Empty() bool {
+	return queue.q.Empty()
+}
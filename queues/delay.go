@@ -0,0 +1,94 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"context"
+	"time"
+
+	internal "github.com/marshall-lee/dope/internal/queues"
+	"github.com/marshall-lee/dope/opt"
+)
+
+// Delay is a blocking queue of elements that become visible only once they are due,
+// e.g. for scheduling retries and delayed jobs. Elements are consumed in the order
+// of their deadlines, elements with equal deadlines are consumed in the same order
+// as they were added. Internally, it's implemented as a synchronized binary heap.
+//
+// When the queue is in an open state and no element is due, all read methods block
+// until either the earliest element becomes due or the queue is closed. Adding an
+// element with an earlier deadline makes the blocked readers wait for it instead.
+// When the queue is in a closed state, all read methods return immediately i.e.
+// they are non-blocking, and the elements that are not due yet are never consumed.
+//
+// Write methods never block. Writing to a closed queue results in panic.
+type Delay[T any] struct {
+	q     internal.Delay[T]
+	clock Clock
+}
+
+// NewDelay makes a new empty delay queue. It respects the [Options.Clock] option
+// and panics if any other option is given.
+func NewDelay[T any](setters ...opt.Setter[Options]) *Delay[T] {
+	var opts Options
+	opt.Apply(&opts, setters...)
+	validateSupported(&opts, "Delay", optionClock)
+	if opts.Clock == nil {
+		opts.Clock = systemClock{}
+	}
+
+	queue := Delay[T]{clock: opts.Clock}
+	queue.q.Init(opts.Clock.Now, opts.Clock.After)
+	return &queue
+}
+
+// PushAt adds an element to the queue which becomes due at the given time.
+// This method panics if the queue is closed.
+func (queue *Delay[T]) PushAt(elem T, at time.Time) {
+	if ok := queue.q.Push(elem, at); !ok {
+		panic(ErrPushClosed)
+	}
+}
+
+// PushAfter adds an element to the queue which becomes due after the given duration.
+// This method panics if the queue is closed.
+func (queue *Delay[T]) PushAfter(elem T, d time.Duration) {
+	queue.PushAt(elem, queue.clock.Now().Add(d))
+}
+
+// Pop attempts to consume the earliest element from the queue. This method blocks
+// until the element is due and returns immediately if the queue is closed.
+func (queue *Delay[T]) Pop() (value T, ok bool) {
+	value, ok, _ = queue.q.PopContext(context.Background())
+	return value, ok
+}
+
+// PopContext is similar to [Delay.Pop] but stops waiting for an element
+// and returns ctx.Err() when ctx is canceled or deadlined.
+func (queue *Delay[T]) PopContext(ctx context.Context) (value T, ok bool, err error) {
+	return queue.q.PopContext(ctx)
+}
+
+// TryPop attempts to consume the earliest element from the queue without blocking.
+// Return value ok is false if no element is due.
+func (queue *Delay[T]) TryPop() (value T, ok bool) {
+	return queue.q.TryPop()
+}
+
+// Len returns the current number of elements in the queue including
+// the ones that are not due yet.
+func (queue *Delay[T]) Len() int {
+	return queue.q.Len()
+}
+
+// Close puts the queue into a closed state. After closing the queue
+// all read methods on it become non-blocking and all write methods
+// on it will panic.
+// Calling this method twice on the same queue also results in panic.
+func (queue *Delay[T]) Close() {
+	if ok := queue.q.Close(); !ok {
+		panic(ErrCloseClosed)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	internal "github.com/marshall-lee/dope/internal/tests"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []fakeTimer
+}
+
+type fakeTimer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return clock.now
+}
+
+func (clock *fakeClock) After(d time.Duration) <-chan time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	clock.timers = append(clock.timers, fakeTimer{at: clock.now.Add(d), ch: ch})
+	return ch
+}
+
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.now = clock.now.Add(d)
+	pending := clock.timers[:0]
+	for _, timer := range clock.timers {
+		if timer.at.After(clock.now) {
+			pending = append(pending, timer)
+		} else {
+			timer.ch <- clock.now
+		}
+	}
+	clock.timers = pending
+}
+
+func (clock *fakeClock) Timers() int {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return len(clock.timers)
+}
+
+// DelayTestSuite tests the delay queue.
+type DelayTestSuite struct {
+	suite.Suite
+	clock *fakeClock
+}
+
+func (s *DelayTestSuite) SetupTest() {
+	s.clock = newFakeClock()
+}
+
+func (s *DelayTestSuite) requireWaiting(pop internal.GoroutineCapture[int]) {
+	s.T().Helper()
+	s.Require().Eventually(func() bool { return s.clock.Timers() > 0 }, 100*time.Millisecond, time.Millisecond, "Pop did not start waiting")
+	s.Require().Never(pop.IsDone, 50*time.Millisecond, 10*time.Millisecond, "Pop unexpectedly returned")
+}
+
+func (s *DelayTestSuite) TestPushAfterPop() {
+	queue := NewDelay[int](WithClock(s.clock))
+	queue.PushAfter(2, 10*time.Second)
+	queue.PushAfter(1, 5*time.Second)
+	s.Require().Equal(2, queue.Len())
+
+	pop := internal.GoCaptureWithReturnValue(func() int { val, _ := queue.Pop(); return val })
+	s.requireWaiting(pop)
+	s.clock.Advance(5 * time.Second)
+	s.Require().Eventually(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().Equal(1, pop.Val())
+
+	pop = internal.GoCaptureWithReturnValue(func() int { val, _ := queue.Pop(); return val })
+	s.requireWaiting(pop)
+	s.clock.Advance(5 * time.Second)
+	s.Require().Eventually(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().Equal(2, pop.Val())
+	s.Require().Equal(0, queue.Len())
+}
+
+func (s *DelayTestSuite) TestEarlierElementWakesReader() {
+	queue := NewDelay[int](WithClock(s.clock))
+	queue.PushAfter(2, time.Hour)
+
+	pop := internal.GoCaptureWithReturnValue(func() int { val, _ := queue.Pop(); return val })
+	s.requireWaiting(pop)
+	queue.PushAt(1, s.clock.Now())
+	s.Require().Eventually(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().Equal(1, pop.Val())
+}
+
+func (s *DelayTestSuite) TestEmptyPopAndPush() {
+	queue := NewDelay[int](WithClock(s.clock))
+	pop := internal.GoCaptureWithReturnValue(func() int { val, _ := queue.Pop(); return val })
+	s.Require().Never(pop.IsDone, 50*time.Millisecond, 10*time.Millisecond, "Pop unexpectedly returned")
+	queue.PushAfter(42, time.Second)
+	s.requireWaiting(pop)
+	s.clock.Advance(time.Second)
+	s.Require().Eventually(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().Equal(42, pop.Val())
+}
+
+func (s *DelayTestSuite) TestStable() {
+	queue := NewDelay[int](WithClock(s.clock))
+	at := s.clock.Now().Add(time.Second)
+	for i := range 5 {
+		queue.PushAt(i, at)
+	}
+	queue.PushAt(-1, at.Add(-time.Millisecond))
+
+	_, ok := queue.TryPop()
+	s.Require().False(ok)
+	s.clock.Advance(time.Second)
+	for i := -1; i < 5; i++ {
+		val, ok := queue.TryPop()
+		s.Require().True(ok)
+		s.Require().Equal(i, val)
+	}
+	_, ok = queue.TryPop()
+	s.Require().False(ok)
+}
+
+func (s *DelayTestSuite) TestPopContext() {
+	queue := NewDelay[int](WithClock(s.clock))
+	queue.PushAfter(42, time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pop := internal.GoCaptureWithReturnValue(func() error { _, _, err := queue.PopContext(ctx); return err })
+	s.Require().Never(pop.IsDone, 50*time.Millisecond, 10*time.Millisecond, "PopContext unexpectedly returned")
+	cancel()
+	s.Require().Eventually(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "PopContext did not return as was expected")
+	s.Require().ErrorIs(pop.Val(), context.Canceled)
+	s.Require().Equal(1, queue.Len())
+}
+
+func (s *DelayTestSuite) TestClose() {
+	queue := NewDelay[int](WithClock(s.clock))
+	queue.PushAfter(1, 0)
+	queue.PushAfter(2, time.Second)
+
+	pop := internal.GoCaptureWithReturnValue(func() int { val, _ := queue.Pop(); return val })
+	s.Require().Eventually(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().Equal(1, pop.Val())
+
+	closed := internal.GoCaptureWithReturnValue(func() bool { _, ok := queue.Pop(); return ok })
+	s.Require().Never(closed.IsDone, 50*time.Millisecond, 10*time.Millisecond, "Pop unexpectedly returned")
+	queue.Close()
+	s.Require().Eventually(closed.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().False(closed.Val())
+
+	// Due elements are still consumable after closing.
+	s.clock.Advance(time.Second)
+	val, ok := queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(2, val)
+
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.PushAfter(3, 0) })
+	s.Require().PanicsWithValue(ErrCloseClosed, func() { queue.Close() })
+}
+
+func (s *DelayTestSuite) TestSystemClock() {
+	queue := NewDelay[int]()
+	queue.PushAfter(42, 20*time.Millisecond)
+	_, ok := queue.TryPop()
+	s.Require().False(ok)
+	pop := internal.GoCaptureWithReturnValue(func() int { val, _ := queue.Pop(); return val })
+	s.Require().Eventually(pop.IsDone, 200*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().Equal(42, pop.Val())
+}
+
+func TestDelay(t *testing.T) {
+	suite.Run(t, new(DelayTestSuite))
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/marshall-lee/dope/opt"
+)
+
+// OverflowPolicy defines what a bounded queue does with an element
+// pushed while it's full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock makes [BlockingBounded] block writers until there is
+	// a room for their elements. [Bounded], which never blocks, rejects
+	// the elements instead. This is the default policy.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest drops the incoming elements and counts them.
+	OverflowDropNewest
+	// OverflowDropOldest overwrites the oldest elements in the queue with
+	// the incoming ones and counts the overwritten ones, making the queue
+	// behave like a ring log.
+	OverflowDropOldest
+)
+
+// Options holds a configuration of a queue. Each queue type documents
+// which options it respects, and its constructor panics if any other
+// option is given.
+type Options struct {
+	// HighWater is the maximum number of elements an unbounded queue holds.
+	// Zero value means no limit.
+	HighWater int
+	// Overflow is a policy of handling elements pushed to a full bounded queue.
+	Overflow OverflowPolicy
+	// BlockTimeout is the maximum time a blocking queue with the
+	// [OverflowBlock] policy waits for a room. When the time is out,
+	// the incoming elements are dropped and counted. The timeout limits
+	// a call as a whole, e.g. all the chunks of [BlockingBounded.PushAll].
+	// Zero value means no limit.
+	BlockTimeout time.Duration
+	// Clock is a source of time for time-based queues.
+	// Nil value means the system clock.
+	Clock Clock
+
+	// given is a set of options given explicitly, including the ones
+	// set to their zero values.
+	given option
+}
+
+// option is a bit set of [Options] fields.
+type option uint8
+
+const (
+	optionHighWater option = 1 << iota
+	optionOverflow
+	optionBlockTimeout
+	optionClock
+)
+
+var optionNames = []struct {
+	option option
+	name   string
+}{
+	{optionHighWater, "HighWater"},
+	{optionOverflow, "Overflow"},
+	{optionBlockTimeout, "BlockTimeout"},
+	{optionClock, "Clock"},
+}
+
+// WithHighWater limits the number of elements an unbounded queue holds.
+func WithHighWater(n int) opt.Setter[Options] {
+	return opt.ApplyFunc(func(opts *Options) {
+		opts.HighWater = n
+		opts.given |= optionHighWater
+	})
+}
+
+// WithOverflow sets a policy of handling elements pushed to a full bounded queue.
+func WithOverflow(policy OverflowPolicy) opt.Setter[Options] {
+	return opt.ApplyFunc(func(opts *Options) {
+		opts.Overflow = policy
+		opts.given |= optionOverflow
+	})
+}
+
+// WithBlockTimeout makes a blocking bounded queue wait for a room no longer
+// than the timeout and then drop the incoming elements.
+// It implies the [OverflowBlock] policy.
+func WithBlockTimeout(timeout time.Duration) opt.Setter[Options] {
+	return opt.ApplyFunc(func(opts *Options) {
+		opts.Overflow = OverflowBlock
+		opts.BlockTimeout = timeout
+		opts.given |= optionBlockTimeout
+	})
+}
+
+// WithClock makes a time-based queue use the clock instead of the system one.
+// It's mostly useful for tests.
+func WithClock(clock Clock) opt.Setter[Options] {
+	return opt.ApplyFunc(func(opts *Options) {
+		opts.Clock = clock
+		opts.given |= optionClock
+	})
+}
+
+// validateSupported panics if any option the queue doesn't respect is given.
+func validateSupported(opts *Options, queue string, supported option) {
+	given := opts.given
+	if opts.HighWater != 0 {
+		given |= optionHighWater
+	}
+	if opts.Overflow != OverflowBlock {
+		given |= optionOverflow
+	}
+	if opts.BlockTimeout != 0 {
+		given |= optionBlockTimeout
+	}
+	if opts.Clock != nil {
+		given |= optionClock
+	}
+	for _, entry := range optionNames {
+		if given&entry.option != 0 && supported&entry.option == 0 {
+			panic(fmt.Errorf("queues: %s doesn't support the %s option", queue, entry.name))
+		}
+	}
+}
+
+// validateOverflow panics if the overflow options are invalid.
+func validateOverflow(opts *Options) {
+	if opts.Overflow < OverflowBlock || opts.Overflow > OverflowDropOldest {
+		panic(fmt.Errorf("queues: unknown overflow policy %v", opts.Overflow))
+	}
+	if opts.BlockTimeout < 0 {
+		panic(fmt.Errorf("queues: block timeout must be a non-negative duration but %v is given", opts.BlockTimeout))
+	}
+}
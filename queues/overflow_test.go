@@ -0,0 +1,193 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	internal "github.com/marshall-lee/dope/internal/tests"
+	"github.com/stretchr/testify/suite"
+)
+
+// OverflowTestSuite tests overflow policies of bounded queues.
+type OverflowTestSuite struct {
+	suite.Suite
+}
+
+func (s *OverflowTestSuite) TestBoundedBlock() {
+	queue := NewBounded[int](2)
+	s.Require().Equal(2, queue.PushSome([]int{1, 2, 3}))
+	s.Require().False(queue.Push(4))
+	s.Require().Equal(uint64(0), queue.Dropped())
+	s.Require().Equal([]int{1, 2}, queue.Slice())
+}
+
+func (s *OverflowTestSuite) TestBoundedDropNewest() {
+	queue := NewBounded[int](2, WithOverflow(OverflowDropNewest))
+	s.Require().Equal(2, queue.PushSome([]int{1, 2, 3}))
+	s.Require().False(queue.Push(4))
+	s.Require().Equal(uint64(2), queue.Dropped())
+	s.Require().Equal([]int{1, 2}, queue.Slice())
+}
+
+func (s *OverflowTestSuite) TestBoundedDropOldest() {
+	queue := NewBounded[int](3, WithOverflow(OverflowDropOldest))
+	s.Require().Equal(2, queue.PushSome([]int{1, 2}))
+	s.Require().True(queue.Push(3))
+	s.Require().Equal(uint64(0), queue.Dropped())
+	s.Require().True(queue.Push(4))
+	s.Require().Equal(uint64(1), queue.Dropped())
+	s.Require().Equal([]int{2, 3, 4}, queue.Slice())
+
+	s.Require().Equal(2, queue.PushSome([]int{5, 6}))
+	s.Require().Equal(uint64(3), queue.Dropped())
+	s.Require().Equal([]int{4, 5, 6}, queue.Slice())
+
+	s.Require().Equal(5, queue.PushSome([]int{7, 8, 9, 10, 11}))
+	s.Require().Equal(uint64(8), queue.Dropped())
+	s.Require().Equal([]int{9, 10, 11}, queue.Slice())
+
+	val, ok := queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(9, val)
+	s.Require().Equal(1, queue.PushSome([]int{12}))
+	s.Require().Equal([]int{10, 11, 12}, queue.Slice())
+}
+
+func (s *OverflowTestSuite) TestBlockingBoundedDropNewest() {
+	queue := NewBlockingBounded[int](2, WithOverflow(OverflowDropNewest))
+	queue.Push(1)
+	queue.PushAll([]int{2, 3, 4})
+	queue.Push(5)
+	s.Require().Equal(0, queue.PushSome([]int{6}))
+	s.Require().Equal(uint64(4), queue.Dropped())
+
+	var out [4]int
+	s.Require().Equal(2, queue.PopSome(out[:]))
+	s.Require().Equal([]int{1, 2}, out[:2])
+}
+
+func (s *OverflowTestSuite) TestBlockingBoundedDropOldest() {
+	queue := NewBlockingBounded[int](2, WithOverflow(OverflowDropOldest))
+	queue.Push(1)
+	queue.PushAll([]int{2, 3, 4})
+	s.Require().NoError(queue.PushContext(context.Background(), 5))
+	s.Require().Equal(uint64(3), queue.Dropped())
+
+	var out [4]int
+	s.Require().Equal(2, queue.PopSome(out[:]))
+	s.Require().Equal([]int{4, 5}, out[:2])
+}
+
+func (s *OverflowTestSuite) TestBlockingBoundedDropOldestUnblocksReader() {
+	queue := NewBlockingBounded[int](1, WithOverflow(OverflowDropOldest))
+	pop := internal.GoCaptureWithReturnValue(func() int { val, _ := queue.Pop(); return val })
+	s.Require().Never(pop.IsDone, 50*time.Millisecond, 10*time.Millisecond, "Pop unexpectedly returned")
+	queue.PushAll([]int{1, 2})
+	s.Require().Eventually(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().Equal(2, pop.Val())
+}
+
+func (s *OverflowTestSuite) TestBlockingBoundedBlockTimeout() {
+	queue := NewBlockingBounded[int](2, WithBlockTimeout(20*time.Millisecond))
+	queue.PushAll([]int{1, 2, 3, 4})
+	s.Require().Equal(uint64(2), queue.Dropped())
+	queue.Push(5)
+	s.Require().Equal(uint64(3), queue.Dropped())
+	s.Require().Equal(2, queue.Len())
+
+	// Context errors take precedence over the timeout.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.Require().ErrorIs(queue.PushContext(ctx, 6), context.Canceled)
+	s.Require().Equal(uint64(3), queue.Dropped())
+}
+
+func (s *OverflowTestSuite) TestBlockingBoundedBlockTimeoutUnblocked() {
+	queue := NewBlockingBounded[int](1, WithBlockTimeout(time.Second))
+	queue.Push(1)
+	push := internal.GoCapture(func() { queue.Push(2) })
+	s.Require().Never(push.IsDone, 50*time.Millisecond, 10*time.Millisecond, "Push unexpectedly returned")
+	queue.Pop()
+	s.Require().Eventually(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Push did not return as was expected")
+	s.Require().Equal(uint64(0), queue.Dropped())
+}
+
+func (s *OverflowTestSuite) TestBlockingBoundedBlockTimeoutPushAll() {
+	queue := NewBlockingBounded[int](1, WithBlockTimeout(100*time.Millisecond))
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		ticker := time.NewTicker(60 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				queue.TryPop()
+			}
+		}
+	}()
+
+	// Every chunk waits less than the timeout but the whole call doesn't.
+	start := time.Now()
+	queue.PushAll([]int{1, 2, 3, 4, 5})
+	s.Require().Less(time.Since(start), 200*time.Millisecond)
+	s.Require().NotZero(queue.Dropped())
+}
+
+func (s *OverflowTestSuite) TestBlockingBoundedBlockTimeoutNoAllocs() {
+	queue := NewBlockingBounded[int](2, WithBlockTimeout(time.Second))
+	values, out := []int{2}, make([]int, 2)
+	allocs := testing.AllocsPerRun(100, func() {
+		queue.Push(1)
+		queue.PushSome(values)
+		queue.PopSome(out)
+	})
+	s.Require().Zero(allocs)
+}
+
+func (s *OverflowTestSuite) TestClosed() {
+	for _, policy := range []OverflowPolicy{OverflowDropNewest, OverflowDropOldest} {
+		queue := NewBlockingBounded[int](1, WithOverflow(policy))
+		queue.Close()
+		s.Require().PanicsWithValue(ErrPushClosed, func() { queue.Push(1) })
+		s.Require().PanicsWithValue(ErrPushClosed, func() { queue.PushSome([]int{1}) })
+		s.Require().PanicsWithValue(ErrPushClosed, func() { queue.PushAll(nil) })
+	}
+}
+
+func (s *OverflowTestSuite) TestInvalidOptions() {
+	s.Require().Panics(func() { NewBounded[int](1, WithOverflow(OverflowPolicy(42))) })
+	s.Require().Panics(func() { NewBlockingBounded[int](1, WithBlockTimeout(-time.Second)) })
+}
+
+func (s *OverflowTestSuite) TestUnsupportedOptions() {
+	s.Require().PanicsWithError("queues: BlockingUnbounded doesn't support the Overflow option", func() {
+		NewBlockingUnbounded[int](WithOverflow(OverflowBlock))
+	})
+	s.Require().PanicsWithError("queues: BlockingUnbounded doesn't support the BlockTimeout option", func() {
+		NewBlockingUnbounded[int](WithBlockTimeout(time.Second))
+	})
+	s.Require().PanicsWithError("queues: Bounded doesn't support the HighWater option", func() {
+		NewBounded[int](1, WithHighWater(0))
+	})
+	s.Require().PanicsWithError("queues: Bounded doesn't support the BlockTimeout option", func() {
+		NewBounded[int](1, WithBlockTimeout(time.Second))
+	})
+	s.Require().PanicsWithError("queues: BlockingBounded doesn't support the Clock option", func() {
+		NewBlockingBounded[int](1, WithClock(nil))
+	})
+	s.Require().PanicsWithError("queues: Delay doesn't support the Overflow option", func() {
+		NewDelay[int](WithOverflow(OverflowDropNewest))
+	})
+}
+
+func TestOverflow(t *testing.T) {
+	suite.Run(t, new(OverflowTestSuite))
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"cmp"
+	"errors"
+
+	internal "github.com/marshall-lee/dope/internal/queues"
+)
+
+var errNilLess = errors.New("queues: less function must not be nil")
+
+// ByKey makes a less function that orders elements by their keys.
+// An ordered type itself can be used with [cmp.Less], e.g. cmp.Less[int].
+func ByKey[T any, K cmp.Ordered](key func(T) K) func(a, b T) bool {
+	return func(a, b T) bool {
+		return cmp.Less(key(a), key(b))
+	}
+}
+
+// Priority is a priority queue that grows as needed. Elements are consumed
+// in the order defined by the less function, i.e. the least element first.
+// Elements of equal priority are consumed in the same order as they were added.
+// Internally, it's implemented as a binary heap whose buffer shrinks
+// when it's mostly unused.
+// All methods are non-blocking and this queue is not suitable for usage by multiple goroutines.
+type Priority[T any] struct {
+	q internal.Priority[T]
+}
+
+// NewPriority makes a new empty priority queue ordered by the less function.
+func NewPriority[T any](less func(a, b T) bool) *Priority[T] {
+	if less == nil {
+		panic(errNilLess)
+	}
+
+	var queue Priority[T]
+	queue.q.Init(less, 0)
+	return &queue
+}
+
+// Push adds an element to the queue.
+func (queue *Priority[T]) Push(elem T) {
+	queue.q.Push(elem)
+}
+
+// PushAll adds to the queue all the elements from the slice.
+func (queue *Priority[T]) PushAll(values []T) {
+	queue.q.PushSome(values)
+}
+
+// Pop attempts to consume the least element from the queue. Return value ok is true
+// whenever the element is successfully consumed. Otherwise, return value ok is
+// false and it basically means that the queue is empty.
+func (queue *Priority[T]) Pop() (value T, ok bool) {
+	return queue.q.Pop()
+}
+
+// PopSome attempts to consume a bunch of the least elements from the queue.
+// Return value is the number of elements consumed from it.
+func (queue *Priority[T]) PopSome(out []T) (n int) {
+	return queue.q.PopSome(out)
+}
+
+// Len returns the current number of elements in the queue.
+func (queue *Priority[T]) Len() int {
+	return queue.q.Len()
+}
+
+// Cap returns the capacity of the underlying buffer.
+func (queue *Priority[T]) Cap() int {
+	return queue.q.BufferCap()
+}
+
+// Empty returns true if the queue is empty.
+func (queue *Priority[T]) Empty() bool {
+	return queue.q.Empty()
+}
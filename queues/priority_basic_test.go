@@ -0,0 +1,216 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"cmp"
+	"context"
+	"testing"
+	"time"
+
+	internal "github.com/marshall-lee/dope/internal/tests"
+	"github.com/stretchr/testify/suite"
+)
+
+// PriorityBasicTestSuite tests Push/Pop methods of priority queues.
+type PriorityBasicTestSuite struct {
+	suite.Suite
+}
+
+type task struct {
+	name     string
+	priority int
+}
+
+func (s *PriorityBasicTestSuite) TestEmptyPop() {
+	queue := NewPriority(cmp.Less[int])
+	s.Require().True(queue.Empty())
+	_, ok := queue.Pop()
+	s.Require().False(ok)
+	s.Require().Equal(0, queue.PopSome(make([]int, 4)))
+}
+
+func (s *PriorityBasicTestSuite) TestPushPop() {
+	queue := NewPriority(cmp.Less[int])
+	queue.PushAll([]int{5, 1, 4})
+	queue.Push(2)
+	queue.Push(3)
+	s.Require().Equal(5, queue.Len())
+
+	out := make([]int, 3)
+	s.Require().Equal(3, queue.PopSome(out))
+	s.Require().Equal([]int{1, 2, 3}, out)
+
+	val, ok := queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(4, val)
+	val, ok = queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(5, val)
+	s.Require().True(queue.Empty())
+}
+
+func (s *PriorityBasicTestSuite) TestStable() {
+	queue := NewPriority(ByKey(func(t task) int { return t.priority }))
+	queue.PushAll([]task{{"a", 2}, {"b", 1}, {"c", 2}, {"d", 1}, {"e", 0}, {"f", 2}})
+
+	var names []string
+	for t, ok := queue.Pop(); ok; t, ok = queue.Pop() {
+		names = append(names, t.name)
+	}
+	s.Require().Equal([]string{"e", "b", "d", "a", "c", "f"}, names)
+}
+
+func (s *PriorityBasicTestSuite) TestGrowAndShrink() {
+	queue := NewPriority(cmp.Less[int])
+	for i := 999; i >= 0; i-- {
+		queue.Push(i)
+	}
+	s.Require().Equal(1000, queue.Len())
+	s.Require().GreaterOrEqual(queue.Cap(), 1000)
+	peak := queue.Cap()
+
+	out := make([]int, 990)
+	s.Require().Equal(990, queue.PopSome(out))
+	for i, value := range out {
+		s.Require().Equal(i, value)
+	}
+	s.Require().Less(queue.Cap(), peak)
+
+	for i := 990; i < 1000; i++ {
+		value, ok := queue.Pop()
+		s.Require().True(ok)
+		s.Require().Equal(i, value)
+	}
+	s.Require().True(queue.Empty())
+	s.Require().LessOrEqual(queue.Cap(), 32)
+}
+
+func (s *PriorityBasicTestSuite) TestNilLessPanic() {
+	s.Require().PanicsWithError("queues: less function must not be nil", func() { NewPriority[int](nil) })
+	s.Require().PanicsWithError("queues: less function must not be nil", func() { NewBoundedPriority[int](1, nil) })
+	s.Require().PanicsWithError("queues: capacity must be a positive integer value but 0 is given", func() { NewBlockingPriority(0, cmp.Less[int]) })
+}
+
+func (s *PriorityBasicTestSuite) TestBounded() {
+	queue := NewBoundedPriority(3, cmp.Less[int])
+	s.Require().Equal(2, queue.PushSome([]int{3, 1}))
+	s.Require().True(queue.Push(2))
+	s.Require().False(queue.Push(0))
+	s.Require().Equal(0, queue.PushSome([]int{0}))
+	s.Require().True(queue.Full())
+	s.Require().Equal(0, queue.Available())
+	s.Require().Equal(3, queue.Cap())
+
+	val, ok := queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(1, val)
+	s.Require().Equal(1, queue.Available())
+	s.Require().True(queue.Push(0))
+
+	out := make([]int, 4)
+	s.Require().Equal(3, queue.PopSome(out))
+	s.Require().Equal([]int{0, 2, 3}, out[:3])
+	s.Require().True(queue.Empty())
+}
+
+func (s *PriorityBasicTestSuite) TestBlockingFullPushAndPop() {
+	queue := NewBlockingPriority(2, cmp.Less[int])
+	queue.PushAll([]int{2, 3})
+	push := internal.GoCapture(func() { queue.Push(1) })
+	s.Require().Never(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Push unexpectedly returned")
+
+	val, ok := queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(2, val)
+	s.Require().Eventually(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Push did not return as was expected")
+
+	out := make([]int, 4)
+	s.Require().Equal(2, queue.PopSome(out))
+	s.Require().Equal([]int{1, 3}, out[:2])
+}
+
+func (s *PriorityBasicTestSuite) TestBlockingContext() {
+	queue := NewBlockingPriority(2, cmp.Less[int])
+	queue.PushAll([]int{2, 3})
+	ctx, cancel := context.WithCancel(context.Background())
+	push := internal.GoCaptureWithReturnValue(func() error { return queue.PushContext(ctx, 1) })
+	s.Require().Never(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "PushContext unexpectedly returned")
+	cancel()
+	s.Require().Eventually(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "PushContext did not return as was expected")
+	s.Require().ErrorIs(push.Val(), context.Canceled)
+
+	n, err := queue.PushAllTimeout([]int{0, 1}, 20*time.Millisecond)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Require().Equal(0, n)
+
+	out := make([]int, 4)
+	n, err = queue.PopSomeContext(context.Background(), out)
+	s.Require().NoError(err)
+	s.Require().Equal([]int{2, 3}, out[:n])
+	_, ok, err := queue.PopTimeout(20 * time.Millisecond)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Require().False(ok)
+
+	queue.Close()
+	_, ok, err = queue.PopContext(context.Background())
+	s.Require().NoError(err)
+	s.Require().False(ok)
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.PushContext(context.Background(), 1) })
+}
+
+func (s *PriorityBasicTestSuite) TestBlockingTry() {
+	queue := NewBlockingPriority(2, cmp.Less[int])
+	_, ok := queue.TryPop()
+	s.Require().False(ok)
+	s.Require().True(queue.TryPush(3))
+	s.Require().Equal(1, queue.TryPushSome([]int{2, 1}))
+	s.Require().False(queue.TryPush(1))
+
+	val, ok := queue.TryPop()
+	s.Require().True(ok)
+	s.Require().Equal(2, val)
+	out := make([]int, 4)
+	s.Require().Equal(1, queue.TryPopSome(out))
+	s.Require().Equal(3, out[0])
+
+	queue.Close()
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.TryPush(1) })
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.TryPushSome([]int{1}) })
+}
+
+func (s *PriorityBasicTestSuite) TestBlockingEmptyPopAndPush() {
+	queue := NewBlockingPriority(2, cmp.Less[int])
+	pop := internal.GoCaptureWithReturnValue(func() int { val, _ := queue.Pop(); return val })
+	s.Require().Never(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Pop unexpectedly returned")
+	queue.Push(42)
+	s.Require().Eventually(pop.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Pop did not return as was expected")
+	s.Require().Equal(42, pop.Val())
+}
+
+func (s *PriorityBasicTestSuite) TestBlockingClose() {
+	queue := NewBlockingPriority(1, cmp.Less[int])
+	queue.Push(1)
+	push := internal.GoCapture(func() { queue.Push(2) })
+	s.Require().Never(push.IsDone, 100*time.Millisecond, 10*time.Millisecond, "Push unexpectedly returned")
+	queue.Close()
+	s.Require().Eventually(push.IsPanicked, 100*time.Millisecond, 10*time.Millisecond, "Push did not panic as was expected")
+
+	val, ok := queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(1, val)
+	_, ok = queue.Pop()
+	s.Require().False(ok)
+	s.Require().Equal(0, queue.PopSome(make([]int, 1)))
+
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.Push(3) })
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.PushSome(nil) })
+	s.Require().PanicsWithValue(ErrPushClosed, func() { queue.PushAll(nil) })
+	s.Require().PanicsWithValue(ErrCloseClosed, func() { queue.Close() })
+}
+
+func TestPriorityBasic(t *testing.T) {
+	suite.Run(t, new(PriorityBasicTestSuite))
+}
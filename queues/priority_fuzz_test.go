@@ -0,0 +1,57 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzPriorityPushPop(f *testing.F) {
+	for _, levels := range []int{1, 2, 3, 5, 8} {
+		for _, x := range bitmaps {
+			f.Add(levels, x)
+		}
+	}
+	f.Fuzz(func(t *testing.T, levels int, x uint32) {
+		if levels <= 0 || levels > 1024 {
+			t.Skip()
+		}
+		type elem struct{ priority, seq int }
+		queue := NewPriority(ByKey(func(e elem) int { return e.priority }))
+		var invariant []elem
+		var seq int
+		for i := 1; i <= 32; i++ {
+			if x&1 == 1 {
+				for range 3 {
+					seq += 1
+					e := elem{priority: (seq * 7) % levels, seq: seq}
+					queue.Push(e)
+					invariant = append(invariant, e)
+				}
+				// The insertion order is kept for equal priorities.
+				slices.SortStableFunc(invariant, func(a, b elem) int { return a.priority - b.priority })
+			} else {
+				value, ok := queue.Pop()
+				if len(invariant) != 0 {
+					require.True(t, ok)
+					require.Equal(t, invariant[0], value)
+					invariant = invariant[1:]
+				} else {
+					require.False(t, ok)
+				}
+			}
+			require.Equal(t, len(invariant), queue.Len())
+			x >>= 1
+		}
+		out := make([]elem, len(invariant))
+		require.Equal(t, len(invariant), queue.PopSome(out))
+		for i := range out {
+			require.Equal(t, invariant[i], out[i])
+		}
+	})
+}
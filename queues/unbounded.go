@@ -0,0 +1,69 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	internal "github.com/marshall-lee/dope/internal/queues"
+)
+
+// Unbounded is a FIFO queue that grows as needed.
+// Internally, it's implemented as a ring buffer which is reallocated
+// twice as large when it's full and is shrunk back when it's mostly empty,
+// so the memory allocated during bursts is eventually released.
+// All methods are non-blocking and this queue is not suitable for usage by multiple goroutines.
+type Unbounded[T any] struct {
+	q internal.Unbounded[T]
+}
+
+// NewUnbounded makes a new empty FIFO queue.
+// The buffer is allocated lazily on the first push.
+func NewUnbounded[T any]() *Unbounded[T] {
+	var queue Unbounded[T]
+	queue.q.Init()
+	return &queue
+}
+
+// Push adds an element to the queue.
+func (queue *Unbounded[T]) Push(elem T) {
+	queue.q.Push(elem)
+}
+
+// PushAll adds to the queue all the elements from the slice.
+func (queue *Unbounded[T]) PushAll(values []T) {
+	queue.q.PushAll(values)
+}
+
+// Pop attempts to consume one element from the queue. Return value ok is true
+// whenever the element is successfully consumed. Otherwise, return value ok is
+// false and it basically means that the queue is empty.
+func (queue *Unbounded[T]) Pop() (value T, ok bool) {
+	return queue.q.Pop()
+}
+
+// PopSome attempts to consume a bunch of elements from the queue.
+// Return value is the number of elements consumed from it.
+func (queue *Unbounded[T]) PopSome(out []T) (n int) {
+	return queue.q.PopSome(out)
+}
+
+// Len returns the current number of elements in the queue.
+func (queue *Unbounded[T]) Len() int {
+	return queue.q.Len()
+}
+
+// Cap returns the capacity of the underlying buffer.
+func (queue *Unbounded[T]) Cap() int {
+	return queue.q.Cap()
+}
+
+// Empty returns true if the queue is empty.
+func (queue *Unbounded[T]) Empty() bool {
+	return queue.q.Empty()
+}
+
+// Slice returns a copy of the queue contents.
+func (queue *Unbounded[T]) Slice() []T {
+	return queue.q.Slice()
+}
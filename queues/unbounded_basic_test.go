@@ -0,0 +1,83 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// UnboundedBasicTestSuite tests Push/Pop methods.
+type UnboundedBasicTestSuite struct {
+	suite.Suite
+}
+
+func (s *UnboundedBasicTestSuite) TestEmptyPop() {
+	queue := NewUnbounded[int]()
+
+	s.Require().True(queue.Empty())
+	_, ok := queue.Pop()
+	s.Require().False(ok)
+	s.Require().Nil(queue.Slice())
+}
+
+func (s *UnboundedBasicTestSuite) TestPushPop() {
+	queue := NewUnbounded[int]()
+	queue.Push(42)
+	queue.Push(43)
+	s.Require().Equal(2, queue.Len())
+	s.Require().Equal([]int{42, 43}, queue.Slice())
+
+	value, ok := queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(42, value)
+
+	value, ok = queue.Pop()
+	s.Require().True(ok)
+	s.Require().Equal(43, value)
+	s.Require().True(queue.Empty())
+}
+
+func (s *UnboundedBasicTestSuite) TestGrowAndShrink() {
+	queue := NewUnbounded[int]()
+	for i := 0; i < 1000; i++ {
+		queue.Push(i)
+	}
+	s.Require().Equal(1000, queue.Len())
+	s.Require().GreaterOrEqual(queue.Cap(), 1000)
+	peak := queue.Cap()
+
+	out := make([]int, 990)
+	s.Require().Equal(990, queue.PopSome(out))
+	for i, value := range out {
+		s.Require().Equal(i, value)
+	}
+	s.Require().Less(queue.Cap(), peak)
+	s.Require().Equal([]int{990, 991, 992, 993, 994, 995, 996, 997, 998, 999}, queue.Slice())
+
+	for i := 990; i < 1000; i++ {
+		value, ok := queue.Pop()
+		s.Require().True(ok)
+		s.Require().Equal(i, value)
+	}
+	s.Require().True(queue.Empty())
+	s.Require().LessOrEqual(queue.Cap(), 32)
+}
+
+func (s *UnboundedBasicTestSuite) TestPushAllWrapped() {
+	queue := NewUnbounded[int]()
+	queue.PushAll([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	out := make([]int, 8)
+	s.Require().Equal(8, queue.PopSome(out))
+	queue.PushAll([]int{11, 12, 13, 14, 15, 16, 17, 18, 19, 20})
+	s.Require().Equal([]int{9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, queue.Slice())
+	s.Require().Equal(12, queue.PopSome(make([]int, 20)))
+	s.Require().True(queue.Empty())
+}
+
+func TestUnboundedBasic(t *testing.T) {
+	suite.Run(t, new(UnboundedBasicTestSuite))
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzUnboundedPushPop(f *testing.F) {
+	for _, burst := range []int{1, 2, 4, 8, 16, 32} {
+		for _, x := range bitmaps {
+			f.Add(burst, x)
+		}
+	}
+	f.Fuzz(func(t *testing.T, burst int, x uint32) {
+		if burst <= 0 || burst > 1024 {
+			t.Skip()
+		}
+		queue := NewUnbounded[int]()
+		var invariant []int
+		var next int
+		for i := 1; i <= 32; i++ {
+			if x&1 == 1 {
+				for j := 0; j < burst; j++ {
+					next += 1
+					queue.Push(next)
+					invariant = append(invariant, next)
+				}
+			} else {
+				value, ok := queue.Pop()
+				if len(invariant) != 0 {
+					require.True(t, ok)
+					require.Equal(t, invariant[0], value)
+					invariant = invariant[1:]
+				} else {
+					require.False(t, ok)
+				}
+			}
+
+			require.Equal(t, len(invariant) == 0, queue.Empty())
+			require.Equal(t, len(invariant), queue.Len())
+			require.GreaterOrEqual(t, queue.Cap(), queue.Len())
+
+			x >>= 1
+		}
+		if len(invariant) == 0 {
+			require.Nil(t, queue.Slice())
+		} else {
+			require.Equal(t, invariant, queue.Slice())
+		}
+	})
+}
+
+func FuzzUnboundedPushAllPopSome(f *testing.F) {
+	for _, burst := range []int{1, 2, 4, 8, 16, 32} {
+		for _, x := range bitmaps {
+			f.Add(burst, x)
+		}
+	}
+	f.Fuzz(func(t *testing.T, burst int, x uint32) {
+		if burst <= 0 || burst > 1024 {
+			t.Skip()
+		}
+		queue := NewUnbounded[int]()
+		invariant := make([]int, 0)
+		for x > 0 {
+			var data []int
+			for i := 1; x&1 == 1; i++ {
+				for j := 0; j < burst; j++ {
+					data = append(data, i*burst+j)
+				}
+				x >>= 1
+			}
+			queue.PushAll(data)
+			invariant = append(invariant, data...)
+
+			if x > 0 {
+				nPop := 0
+				for x&1 == 0 {
+					nPop += burst
+					x >>= 1
+				}
+				out := make([]int, nPop)
+				n := queue.PopSome(out)
+				if nPop > len(invariant) {
+					nPop = len(invariant)
+				}
+				require.Equal(t, nPop, n)
+				require.Equal(t, invariant[:nPop], out[:n])
+				invariant = invariant[nPop:]
+			}
+
+			require.Equal(t, len(invariant) == 0, queue.Empty())
+			require.Equal(t, len(invariant), queue.Len())
+			require.GreaterOrEqual(t, queue.Cap(), queue.Len())
+		}
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import "context"
+
+// consumable is implemented by futures that can be consumed
+// by derived futures, combinators and adapters.
+type consumable interface {
+	acquire()
+	release(propagate bool)
+}
+
+// upstreamRef is a reference to a future this future depends on.
+// Only references made by derived futures propagate cancellation.
+type upstreamRef struct {
+	source    consumable
+	propagate bool
+}
+
+// GoContext runs a function in a new goroutine and returns a future of its result.
+// The function receives a context derived from ctx which is canceled
+// either when the future is canceled using [Future.Cancel] or when it's completed.
+// If the function panics, the future fails with a [*PanicError].
+func GoContext[V any](ctx context.Context, fn func(ctx context.Context) (V, error)) *Future[V] {
+	ctx, cancel := context.WithCancel(ctx)
+	future := New[V]()
+	future.cancel = cancel
+	go run(future, func() (V, error) { return fn(ctx) })
+	return future
+}
+
+// Cancel tells that the future result is no longer needed. Unless the future
+// is already completed, it fails with [context.Canceled] and the producer's
+// context is canceled if it was created using [GoContext].
+//
+// Cancellation is propagated upstream: a future that derived futures were
+// made of (e.g. using [Map] or [Then]) is canceled as well once all of
+// its derived futures are canceled and no other consumers remain.
+// Futures made with combinators such as [All] or [Race] and adapters such as
+// [AsCompleted] also count as consumers but they never cancel anything.
+// Note that plain holders, e.g. the ones calling [Future.Await], are not
+// tracked. Hence cancellation is never propagated to the futures that are
+// shared by design, i.e. the ones made by [Group.Do] and [Registry.Register].
+// Other futures shared with plain holders should not be consumed by derived
+// futures that may be canceled.
+func (future *Future[V]) Cancel() {
+	future.TryFail(context.Canceled)
+}
+
+// consume registers the future as a consumer of an upstream future
+// which is canceled once all of its consumers are gone.
+func (future *Future[V]) consume(source consumable) {
+	future.link(upstreamRef{source: source, propagate: true})
+}
+
+// hold registers the future as a consumer of an upstream future
+// which is kept alive until the future is completed.
+func (future *Future[V]) hold(source consumable) {
+	future.link(upstreamRef{source: source})
+}
+
+func (future *Future[V]) link(ref upstreamRef) {
+	ref.source.acquire()
+
+	future.mu.Lock()
+	select {
+	case <-future.done:
+		future.mu.Unlock()
+		ref.source.release(ref.propagate)
+	default:
+		future.upstream = append(future.upstream, ref)
+		future.mu.Unlock()
+	}
+}
+
+func (future *Future[V]) acquire() {
+	future.mu.Lock()
+	future.consumers += 1
+	future.mu.Unlock()
+}
+
+// release unregisters a consumer. If propagate is true and it was the
+// last one, the future is canceled unless it's shared.
+func (future *Future[V]) release(propagate bool) {
+	future.mu.Lock()
+	future.consumers -= 1
+	last := future.consumers == 0 && !future.shared
+	future.mu.Unlock()
+
+	if last && propagate {
+		future.Cancel()
+	}
+}
+
+// holdAll registers the future as a consumer of all the futures
+// that support it.
+func holdAll[V, U any](future *Future[U], sources []awaitable[V]) {
+	for _, source := range sources {
+		switch source := any(source).(type) {
+		case consumable:
+			future.hold(source)
+		case untypedAwaitable:
+			if source, ok := source.UntypedInterface.(consumable); ok {
+				future.hold(source)
+			}
+		}
+	}
+}
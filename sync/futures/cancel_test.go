@@ -0,0 +1,181 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoContextCancel(t *testing.T) {
+	canceled := make(chan struct{})
+	future := GoContext(context.Background(), func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		close(canceled)
+		return 0, ctx.Err()
+	})
+	requireNotDone(t, future)
+	future.Cancel()
+	<-canceled
+	_, err := requireDone(t, future)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGoContextCompleted(t *testing.T) {
+	var producerCtx context.Context
+	future := GoContext(context.Background(), func(ctx context.Context) (int, error) {
+		producerCtx = ctx
+		return 42, nil
+	})
+	value, err := future.Await()
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+	require.ErrorIs(t, producerCtx.Err(), context.Canceled)
+
+	future.Cancel()
+	value, err = future.Await()
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
+
+func TestCancelPlain(t *testing.T) {
+	future := New[int]()
+	future.Cancel()
+	_, err := requireDone(t, future)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMap(t *testing.T) {
+	future := New[int]()
+	mapped := Map(future, func(value int) (string, error) { return strconv.Itoa(value), nil })
+	future.Complete(42)
+	value, err := requireDone(t, mapped)
+	require.NoError(t, err)
+	require.Equal(t, "42", value)
+}
+
+func TestMapFail(t *testing.T) {
+	errTest := errors.New("test")
+	future := New[int]()
+	mapped := Map(future, func(value int) (string, error) { return "", nil })
+	future.Fail(errTest)
+	_, err := requireDone(t, mapped)
+	require.ErrorIs(t, err, errTest)
+
+	future = New[int]()
+	mapped = Map(future, func(value int) (string, error) { panic("boom") })
+	future.Complete(42)
+	_, err = requireDone(t, mapped)
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+}
+
+func TestThen(t *testing.T) {
+	future := New[int]()
+	next := New[string]()
+	result := Then(future, func(value int) *Future[string] { return next })
+	future.Complete(42)
+	requireNotDone(t, result)
+	next.Complete("foo")
+	value, err := requireDone(t, result)
+	require.NoError(t, err)
+	require.Equal(t, "foo", value)
+}
+
+func TestCancelPropagation(t *testing.T) {
+	source := GoContext(context.Background(), func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	a := Map(source, func(value int) (int, error) { return value, nil })
+	b := Map(source, func(value int) (int, error) { return value, nil })
+
+	a.Cancel()
+	_, err := requireDone(t, a)
+	require.ErrorIs(t, err, context.Canceled)
+	requireNotDone(t, source)
+
+	b.Cancel()
+	_, err = requireDone(t, source)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCancelPropagationThen(t *testing.T) {
+	source := New[int]()
+	next := New[string]()
+	result := Then(source, func(value int) *Future[string] { return next })
+	source.Complete(42)
+	require.Eventually(t, func() bool {
+		next.mu.Lock()
+		defer next.mu.Unlock()
+		return next.consumers == 1
+	}, awaitTimeout, awaitTimeout/20)
+
+	result.Cancel()
+	_, err := requireDone(t, next)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCancelPropagationWithCombinator(t *testing.T) {
+	source := New[int]()
+	all := All(source)
+	mapped := Map(source, func(value int) (int, error) { return value, nil })
+	mapped.Cancel()
+	_, err := requireDone(t, mapped)
+	require.ErrorIs(t, err, context.Canceled)
+	requireNotDone(t, source)
+	requireNotDone(t, all)
+
+	source.Complete(42)
+	values, err := requireDone(t, all)
+	require.NoError(t, err)
+	require.Equal(t, []int{42}, values)
+}
+
+func TestCancelPropagationWithAdapter(t *testing.T) {
+	source := New[int]()
+	ch := AsCompleted(source)
+	Map(source, func(value int) (int, error) { return value, nil }).Cancel()
+	requireNotDone(t, source)
+
+	source.Complete(42)
+	require.Equal(t, Result[int]{Value: 42}, <-ch)
+}
+
+func TestCancelPropagationShared(t *testing.T) {
+	var group Group[string, int]
+	release := make(chan struct{})
+	shared := group.Do("key", func() (int, error) {
+		<-release
+		return 42, nil
+	})
+	mapped := Map(shared, func(value int) (int, error) { return value, nil })
+	mapped.Cancel()
+	requireNotDone(t, shared)
+	close(release)
+	value, err := requireDone(t, shared)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+
+	registry := NewRegistry[int, string](newCounter())
+	key, pending := registry.Register()
+	Then(pending, func(value string) *Future[string] { return New[string]() }).Cancel()
+	requireNotDone(t, pending)
+	require.True(t, registry.Complete(key, "foo"))
+}
+
+func TestCombinatorDoesNotCancel(t *testing.T) {
+	a, b := New[int](), New[int]()
+	race := Race(a, b)
+	a.Complete(42)
+	_, err := requireDone(t, race)
+	require.NoError(t, err)
+	requireNotDone(t, b)
+	b.Complete(43)
+}
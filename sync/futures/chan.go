@@ -0,0 +1,72 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// ErrChanClosed is an error of a future made with [FromChan]
+// when the channel is closed before any value is received.
+var ErrChanClosed = errors.New("futures: channel is closed")
+
+// Chan returns a channel that receives the future result once it's
+// completed and then is closed. The channel is buffered so the result
+// is never lost even if nobody receives from it.
+func (future *Future[V]) Chan() <-chan Result[V] {
+	ch := make(chan Result[V], 1)
+	future.acquire()
+	future.subscribe(func() {
+		future.release(false)
+		ch <- ResultOf(future.get())
+		close(ch)
+	})
+	return ch
+}
+
+// FromChan returns a future of the first value received from the channel.
+// If the channel is closed before that, the future fails with [ErrChanClosed].
+// Canceling the future stops receiving from the channel.
+func FromChan[V any](ch <-chan V) *Future[V] {
+	future := New[V]()
+	go func() {
+		select {
+		case value, ok := <-ch:
+			if ok {
+				future.TryComplete(value)
+			} else {
+				future.TryFail(ErrChanClosed)
+			}
+		case <-future.done:
+		}
+	}()
+	return future
+}
+
+// AsCompleted returns a channel that receives the futures results
+// in the order of their completion. The channel is closed after all
+// the futures are completed.
+func AsCompleted[V any](futures ...*Future[V]) <-chan Result[V] {
+	ch := make(chan Result[V], len(futures))
+	if len(futures) == 0 {
+		close(ch)
+		return ch
+	}
+
+	var remaining atomic.Int64
+	remaining.Store(int64(len(futures)))
+	for _, future := range futures {
+		future.acquire()
+		future.subscribe(func() {
+			future.release(false)
+			ch <- ResultOf(future.get())
+			if remaining.Add(-1) == 0 {
+				close(ch)
+			}
+		})
+	}
+	return ch
+}
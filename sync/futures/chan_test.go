@@ -0,0 +1,71 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChan(t *testing.T) {
+	future := New[int]()
+	ch := future.Chan()
+	select {
+	case <-ch:
+		require.Fail(t, "channel must not receive yet")
+	default:
+	}
+	future.Complete(42)
+	require.Equal(t, Result[int]{Value: 42}, <-ch)
+	_, ok := <-ch
+	require.False(t, ok)
+
+	errTest := errors.New("test")
+	future = New[int]()
+	future.Fail(errTest)
+	require.Equal(t, Result[int]{Err: errTest}, <-future.Chan())
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int)
+	future := FromChan(ch)
+	requireNotDone(t, future)
+	ch <- 42
+	value, err := requireDone(t, future)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+
+	ch = make(chan int)
+	future = FromChan(ch)
+	close(ch)
+	_, err = requireDone(t, future)
+	require.ErrorIs(t, err, ErrChanClosed)
+
+	future = FromChan(make(chan int))
+	future.Cancel()
+	_, err = requireDone(t, future)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestAsCompleted(t *testing.T) {
+	errTest := errors.New("test")
+	a, b, c := New[int](), New[int](), New[int]()
+	ch := AsCompleted(a, b, c)
+	b.Complete(2)
+	c.Fail(errTest)
+	a.Complete(1)
+
+	var results []Result[int]
+	for result := range ch {
+		results = append(results, result)
+	}
+	require.Equal(t, []Result[int]{{Value: 2}, {Err: errTest}, {Value: 1}}, results)
+
+	_, ok := <-AsCompleted[int]()
+	require.False(t, ok)
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrAllFailed is returned by a future made with [Any] when none of the
+// given futures succeeded. The actual error also wraps every individual
+// failure so they can be inspected using [errors.Is] and [errors.As].
+var ErrAllFailed = errors.New("futures: all futures failed")
+
+// All waits for all the futures to succeed and returns a future of their values
+// in the same order. If any of the futures fails, the returned future
+// immediately fails with the same error.
+func All[V any](futures ...*Future[V]) *Future[[]V] {
+	return all(typed(futures))
+}
+
+// AllUntyped is similar to [All] but accepts a collection of futures
+// of different types.
+func AllUntyped(futures ...UntypedInterface) *Future[[]any] {
+	return all(untyped(futures))
+}
+
+// AllSettled waits for all the futures to complete and returns a future
+// of their results in the same order. The returned future never fails.
+func AllSettled[V any](futures ...*Future[V]) *Future[[]Result[V]] {
+	return allSettled(typed(futures))
+}
+
+// AllSettledUntyped is similar to [AllSettled] but accepts a collection of futures
+// of different types.
+func AllSettledUntyped(futures ...UntypedInterface) *Future[[]Result[any]] {
+	return allSettled(untyped(futures))
+}
+
+// Any returns a future of the first successfully completed future value.
+// If all the futures fail, the returned future fails with an error
+// that wraps [ErrAllFailed] and every individual failure.
+func Any[V any](futures ...*Future[V]) *Future[V] {
+	return anyOf(typed(futures))
+}
+
+// AnyUntyped is similar to [Any] but accepts a collection of futures
+// of different types.
+func AnyUntyped(futures ...UntypedInterface) *Future[any] {
+	return anyOf(untyped(futures))
+}
+
+// Race returns a future that completes in the same way as the first
+// completed future does, be it a success or a failure.
+// If no futures are given, the returned future never completes.
+func Race[V any](futures ...*Future[V]) *Future[V] {
+	return race(typed(futures))
+}
+
+// RaceUntyped is similar to [Race] but accepts a collection of futures
+// of different types.
+func RaceUntyped(futures ...UntypedInterface) *Future[any] {
+	return race(untyped(futures))
+}
+
+// awaitable is a common denominator of typed and untyped futures
+// that combinators operate on.
+type awaitable[V any] interface {
+	Done() <-chan struct{}
+	get() (V, error)
+}
+
+type untypedAwaitable struct {
+	UntypedInterface
+}
+
+func (untyped untypedAwaitable) get() (any, error) {
+	value, err, _ := untyped.GetUntyped()
+	return value, err
+}
+
+func typed[V any](futures []*Future[V]) []awaitable[V] {
+	result := make([]awaitable[V], len(futures))
+	for i, future := range futures {
+		result[i] = future
+	}
+	return result
+}
+
+func untyped(futures []UntypedInterface) []awaitable[any] {
+	result := make([]awaitable[any], len(futures))
+	for i, future := range futures {
+		result[i] = untypedAwaitable{future}
+	}
+	return result
+}
+
+// await blocks until either the source or the target future is completed.
+// Return value is false if the target future was completed first.
+func await[V, U any](source awaitable[V], target *Future[U]) (ok bool) {
+	select {
+	case <-source.Done():
+		return true
+	case <-target.Done():
+		return false
+	}
+}
+
+func all[V any](futures []awaitable[V]) *Future[[]V] {
+	result := New[[]V]()
+	holdAll(result, futures)
+	values := make([]V, len(futures))
+	if len(futures) == 0 {
+		result.Complete(values)
+		return result
+	}
+
+	var remaining atomic.Int64
+	remaining.Store(int64(len(futures)))
+	for i, future := range futures {
+		go func() {
+			if !await(future, result) {
+				return
+			}
+			value, err := future.get()
+			if err != nil {
+				result.TryFail(err)
+				return
+			}
+			values[i] = value
+			if remaining.Add(-1) == 0 {
+				result.TryComplete(values)
+			}
+		}()
+	}
+	return result
+}
+
+func allSettled[V any](futures []awaitable[V]) *Future[[]Result[V]] {
+	result := New[[]Result[V]]()
+	holdAll(result, futures)
+	results := make([]Result[V], len(futures))
+	if len(futures) == 0 {
+		result.Complete(results)
+		return result
+	}
+
+	var remaining atomic.Int64
+	remaining.Store(int64(len(futures)))
+	for i, future := range futures {
+		go func() {
+			<-future.Done()
+			results[i] = ResultOf(future.get())
+			if remaining.Add(-1) == 0 {
+				result.TryComplete(results)
+			}
+		}()
+	}
+	return result
+}
+
+func anyOf[V any](futures []awaitable[V]) *Future[V] {
+	result := New[V]()
+	holdAll(result, futures)
+	if len(futures) == 0 {
+		result.Fail(ErrAllFailed)
+		return result
+	}
+
+	var (
+		mu        sync.Mutex
+		errs      = make([]error, len(futures))
+		remaining = len(futures)
+	)
+	for i, future := range futures {
+		go func() {
+			if !await(future, result) {
+				return
+			}
+			value, err := future.get()
+			if err == nil {
+				result.TryComplete(value)
+				return
+			}
+
+			mu.Lock()
+			errs[i] = err
+			remaining -= 1
+			last := remaining == 0
+			mu.Unlock()
+
+			if last {
+				result.TryFail(errors.Join(append([]error{ErrAllFailed}, errs...)...))
+			}
+		}()
+	}
+	return result
+}
+
+func race[V any](futures []awaitable[V]) *Future[V] {
+	result := New[V]()
+	holdAll(result, futures)
+	for _, future := range futures {
+		go func() {
+			if !await(future, result) {
+				return
+			}
+			result.settle(future.get())
+		}()
+	}
+	return result
+}
+
+// Map returns a future of a result of fn applied to the future value.
+// If the future fails, the returned future fails with the same error.
+// If fn panics, the returned future fails with a [*PanicError].
+func Map[V, U any](future *Future[V], fn func(V) (U, error)) *Future[U] {
+	result := New[U]()
+	result.consume(future)
+	go run(result, func() (U, error) {
+		if !await(future, result) {
+			// The result is already set so it's returned as is.
+			return result.get()
+		}
+		value, err := future.get()
+		if err != nil {
+			return result.makeEmpty(), err
+		}
+		return fn(value)
+	})
+	return result
+}
+
+// Then is similar to [Map] but fn returns a future which the returned
+// future is eventually completed with.
+func Then[V, U any](future *Future[V], fn func(V) *Future[U]) *Future[U] {
+	result := New[U]()
+	result.consume(future)
+	go run(result, func() (U, error) {
+		if !await(future, result) {
+			return result.get()
+		}
+		value, err := future.get()
+		if err != nil {
+			return result.makeEmpty(), err
+		}
+		next := fn(value)
+		result.consume(next)
+		if !await(next, result) {
+			return result.get()
+		}
+		return next.get()
+	})
+	return result
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const awaitTimeout = 200 * time.Millisecond
+
+func requireDone[V any](t *testing.T, future *Future[V]) (V, error) {
+	t.Helper()
+	select {
+	case <-future.Done():
+	case <-time.After(awaitTimeout):
+		require.FailNow(t, "future did not complete as was expected")
+	}
+	value, err, ok := future.Get()
+	require.True(t, ok)
+	return value, err
+}
+
+func requireNotDone[V any](t *testing.T, future *Future[V]) {
+	t.Helper()
+	select {
+	case <-future.Done():
+		require.FailNow(t, "future completed unexpectedly")
+	case <-time.After(awaitTimeout / 4):
+	}
+}
+
+func TestAll(t *testing.T) {
+	a, b, c := New[int](), New[int](), New[int]()
+	all := All(a, b, c)
+	c.Complete(3)
+	a.Complete(1)
+	requireNotDone(t, all)
+	b.Complete(2)
+	values, err := requireDone(t, all)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, values)
+}
+
+func TestAllFailFast(t *testing.T) {
+	errTest := errors.New("test")
+	a, b := New[int](), New[int]()
+	all := All(a, b)
+	b.Fail(errTest)
+	_, err := requireDone(t, all)
+	require.ErrorIs(t, err, errTest)
+}
+
+func TestAllEmpty(t *testing.T) {
+	values, err := requireDone(t, All[int]())
+	require.NoError(t, err)
+	require.Empty(t, values)
+}
+
+func TestAllUntyped(t *testing.T) {
+	a, b := New[int](), NewUntyped()
+	all := AllUntyped(a, b)
+	a.Complete(42)
+	b.CompleteUntyped("foo")
+	values, err := requireDone(t, all)
+	require.NoError(t, err)
+	require.Equal(t, []any{42, "foo"}, values)
+}
+
+func TestAllSettled(t *testing.T) {
+	errTest := errors.New("test")
+	a, b := New[int](), New[int]()
+	all := AllSettled(a, b)
+	b.Fail(errTest)
+	requireNotDone(t, all)
+	a.Complete(42)
+	results, err := requireDone(t, all)
+	require.NoError(t, err)
+	require.Equal(t, []Result[int]{{Value: 42}, {Err: errTest}}, results)
+}
+
+func TestAllSettledUntyped(t *testing.T) {
+	errTest := errors.New("test")
+	a, b := New[string](), NewUntyped()
+	all := AllSettledUntyped(a, b)
+	a.Complete("foo")
+	b.Fail(errTest)
+	results, err := requireDone(t, all)
+	require.NoError(t, err)
+	require.Equal(t, []Result[any]{{Value: "foo"}, {Err: errTest}}, results)
+}
+
+func TestAny(t *testing.T) {
+	a, b := New[int](), New[int]()
+	future := Any(a, b)
+	a.Fail(errors.New("test"))
+	requireNotDone(t, future)
+	b.Complete(42)
+	value, err := requireDone(t, future)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
+
+func TestAnyAllFailed(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	a, b := New[int](), NewUntyped()
+	future := AnyUntyped(a, b)
+	b.Fail(errB)
+	a.Fail(errA)
+	_, err := requireDone(t, future)
+	require.ErrorIs(t, err, ErrAllFailed)
+	require.ErrorIs(t, err, errA)
+	require.ErrorIs(t, err, errB)
+}
+
+func TestAnyEmpty(t *testing.T) {
+	_, err := requireDone(t, Any[int]())
+	require.ErrorIs(t, err, ErrAllFailed)
+}
+
+func TestRace(t *testing.T) {
+	errTest := errors.New("test")
+	a, b := New[int](), New[int]()
+	race := Race(a, b)
+	requireNotDone(t, race)
+	b.Fail(errTest)
+	_, err := requireDone(t, race)
+	require.ErrorIs(t, err, errTest)
+	a.Complete(42)
+}
+
+func TestRaceUntyped(t *testing.T) {
+	a, b := New[int](), NewUntyped()
+	race := RaceUntyped(a, b)
+	a.Complete(42)
+	value, err := requireDone(t, race)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
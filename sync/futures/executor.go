@@ -0,0 +1,96 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"runtime/debug"
+	"slices"
+)
+
+// Executor runs tasks on behalf of futures e.g. completion callbacks.
+type Executor interface {
+	// Execute runs a task or schedules it to be run later.
+	// An error is returned if the task cannot be accepted.
+	Execute(task func()) error
+}
+
+// ExecutorFunc wraps a Go function into an [Executor].
+type ExecutorFunc func(task func()) error
+
+// Execute implements an [Executor] interface.
+func (fn ExecutorFunc) Execute(task func()) error {
+	return fn(task)
+}
+
+// Inline is an [Executor] that runs tasks immediately in the calling goroutine.
+var Inline Executor = ExecutorFunc(func(task func()) error {
+	task()
+	return nil
+})
+
+// OnComplete registers a callback which is invoked exactly once after
+// the future is completed. If the future is already completed, the callback
+// is invoked immediately. Otherwise, it's invoked by the goroutine that
+// completes the future, so the callback is expected to be quick and
+// non-blocking. Use [Future.OnCompleteWith] to offload it somewhere else.
+// A panicking callback affects neither the goroutine that completes the future
+// nor the other callbacks, the panic is raised again in a new goroutine.
+func (future *Future[V]) OnComplete(callback func(value V, err error)) {
+	future.OnCompleteWith(Inline, callback)
+}
+
+// OnCompleteWith is similar to [Future.OnComplete] but the callback is run
+// by the given executor. If the executor refuses to accept the callback,
+// it's invoked inline.
+func (future *Future[V]) OnCompleteWith(exec Executor, callback func(value V, err error)) {
+	future.subscribe(func() {
+		task := func() { callback(future.get()) }
+		if err := exec.Execute(task); err != nil {
+			task()
+		}
+	})
+}
+
+// callbackPanicked is invoked with a panic recovered from a completion
+// callback. The panic is raised again in a new goroutine, so it crashes the
+// program as it would do with an asynchronous executor, but neither the
+// goroutine completing the future nor the other callbacks are affected.
+var callbackPanicked = func(err *PanicError) {
+	go func() { panic(err) }()
+}
+
+// invokeCallback calls a completion callback isolating its panic.
+func invokeCallback(callback func()) {
+	defer func() {
+		if value := recover(); value != nil {
+			callbackPanicked(&PanicError{Value: value, Stack: debug.Stack()})
+		}
+	}()
+	callback()
+}
+
+// subscribe registers a function which is invoked once the future is completed.
+// If it's already completed, the function is invoked immediately with its
+// panic isolated in the same way. The returned function unregisters it
+// unless it's already invoked.
+func (future *Future[V]) subscribe(fn func()) (unsubscribe func()) {
+	future.mu.Lock()
+	select {
+	case <-future.done:
+		future.mu.Unlock()
+		invokeCallback(fn)
+		return func() {}
+	default:
+	}
+	callback := &fn
+	future.callbacks = append(future.callbacks, callback)
+	future.mu.Unlock()
+
+	return func() {
+		future.mu.Lock()
+		future.callbacks = slices.DeleteFunc(future.callbacks, func(c *func()) bool { return c == callback })
+		future.mu.Unlock()
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnComplete(t *testing.T) {
+	future := New[int]()
+	var calls []int
+	future.OnComplete(func(value int, err error) {
+		require.NoError(t, err)
+		calls = append(calls, value)
+	})
+	future.OnComplete(func(value int, err error) {
+		calls = append(calls, value+1)
+	})
+	require.Empty(t, calls)
+
+	future.Complete(42)
+	require.Equal(t, []int{42, 43}, calls)
+
+	future.OnComplete(func(value int, err error) {
+		calls = append(calls, value+2)
+	})
+	require.Equal(t, []int{42, 43, 44}, calls)
+
+	future.TryComplete(0)
+	require.Equal(t, []int{42, 43, 44}, calls)
+}
+
+func TestOnCompleteFail(t *testing.T) {
+	errTest := errors.New("test")
+	future := New[int]()
+	var called error
+	future.OnComplete(func(value int, err error) { called = err })
+	future.Fail(errTest)
+	require.ErrorIs(t, called, errTest)
+}
+
+func TestOnCompleteWith(t *testing.T) {
+	var tasks []func()
+	exec := ExecutorFunc(func(task func()) error {
+		tasks = append(tasks, task)
+		return nil
+	})
+
+	future := New[int]()
+	var called bool
+	future.OnCompleteWith(exec, func(value int, err error) { called = true })
+	future.Complete(42)
+	require.False(t, called)
+	require.Len(t, tasks, 1)
+	tasks[0]()
+	require.True(t, called)
+}
+
+func TestOnCompleteWithRefusingExecutor(t *testing.T) {
+	exec := ExecutorFunc(func(task func()) error { return errors.New("refused") })
+	future := New[int]()
+	var called bool
+	future.OnCompleteWith(exec, func(value int, err error) { called = true })
+	future.Complete(42)
+	require.True(t, called)
+}
+
+func TestOnCompletePanic(t *testing.T) {
+	var panics []*PanicError
+	original := callbackPanicked
+	callbackPanicked = func(err *PanicError) { panics = append(panics, err) }
+	t.Cleanup(func() { callbackPanicked = original })
+
+	source := New[int]()
+	mapped := Map(source, func(value int) (int, error) { return value, nil })
+	var called bool
+	mapped.OnComplete(func(value int, err error) { panic("boom") })
+	mapped.OnComplete(func(value int, err error) { called = true })
+
+	require.NotPanics(t, mapped.Cancel)
+	require.True(t, called)
+	require.Len(t, panics, 1)
+	require.Equal(t, "boom", panics[0].Value)
+
+	// The upstream is released despite the panic.
+	_, err := requireDone(t, source)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// The same holds for a future that is already completed.
+	require.NotPanics(t, func() {
+		mapped.OnComplete(func(value int, err error) { panic("boom again") })
+	})
+	require.Len(t, panics, 2)
+	require.Equal(t, "boom again", panics[1].Value)
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/marshall-lee/dope/queues"
+)
+
+// ErrExecutorClosed is returned when a task is submitted to an executor
+// that is shut down, and it's also an error of pending futures failed
+// by [FixedExecutor.ShutdownNow].
+var ErrExecutorClosed = errors.New("futures: executor is closed")
+
+// FixedExecutor is an [Executor] that runs tasks using a fixed number of
+// worker goroutines. Pending tasks are kept in a blocking FIFO queue of
+// a fixed capacity, so submitting a task blocks while the queue is full.
+type FixedExecutor struct {
+	queue   *queues.BlockingBounded[func(err error)]
+	closing sync.Once
+	wg      sync.WaitGroup
+	aborted atomic.Bool
+}
+
+// NewFixedExecutor makes a new executor with a given number of workers
+// and a given capacity of the pending tasks queue.
+// Both are expected to be positive non-zero integer values, otherwise
+// this function panics.
+func NewFixedExecutor(workers, cap int) *FixedExecutor {
+	if workers <= 0 {
+		panic(fmt.Errorf("futures: number of workers must be a positive integer value but %v is given", workers))
+	}
+	if cap <= 0 {
+		panic(fmt.Errorf("futures: capacity must be a positive integer value but %v is given", cap))
+	}
+
+	exec := FixedExecutor{queue: queues.NewBlockingBounded[func(err error)](cap)}
+	for i := 0; i < workers; i++ {
+		exec.spawn()
+	}
+	return &exec
+}
+
+// Execute implements an [Executor] interface. It blocks while the queue of
+// pending tasks is full and returns [ErrExecutorClosed] if the executor
+// is shut down. A task accepted by this method is always run, even if
+// the executor is shut down using [FixedExecutor.ShutdownNow].
+func (exec *FixedExecutor) Execute(task func()) error {
+	return exec.submit(func(error) { task() })
+}
+
+// Shutdown stops accepting new tasks and blocks until all the pending
+// tasks are run and the workers are stopped.
+func (exec *FixedExecutor) Shutdown() {
+	exec.closing.Do(exec.queue.Close)
+	exec.wg.Wait()
+}
+
+// ShutdownNow stops accepting new tasks and blocks until the running tasks
+// are finished and the workers are stopped. Futures of the functions passed to
+// [Submit] that are still pending fail with [ErrExecutorClosed] instead of
+// being run. Tasks accepted by [FixedExecutor.Execute] are run anyway.
+func (exec *FixedExecutor) ShutdownNow() {
+	exec.aborted.Store(true)
+	exec.Shutdown()
+}
+
+// Submit runs a function using the executor and returns a future of its result.
+// If the executor refuses to accept the function, the future fails with
+// the executor error. If the function panics, the future fails with a [*PanicError].
+//
+// A function submitted to a [FixedExecutor] is skipped if its future
+// is canceled before the function is started.
+func Submit[V any](exec Executor, fn func() (V, error)) *Future[V] {
+	future := New[V]()
+	task := func(err error) {
+		if err != nil {
+			future.TryFail(err)
+			return
+		}
+		select {
+		case <-future.done:
+			return
+		default:
+			run(future, fn)
+		}
+	}
+
+	var err error
+	if fixed, ok := exec.(*FixedExecutor); ok {
+		err = fixed.submit(task)
+	} else {
+		err = exec.Execute(func() { task(nil) })
+	}
+	if err != nil {
+		future.TryFail(err)
+	}
+	return future
+}
+
+func (exec *FixedExecutor) submit(task func(err error)) (err error) {
+	// The queue panics if it's closed before or while the task is pushed.
+	defer func() {
+		if value := recover(); value != nil {
+			if value != queues.ErrPushClosed {
+				panic(value)
+			}
+			err = ErrExecutorClosed
+		}
+	}()
+	exec.queue.Push(task)
+	return nil
+}
+
+func (exec *FixedExecutor) spawn() {
+	exec.wg.Add(1)
+	go exec.work()
+}
+
+func (exec *FixedExecutor) work() {
+	var returned bool
+	defer func() {
+		// A task has called runtime.Goexit so the worker is replaced.
+		if !returned {
+			exec.spawn()
+		}
+		exec.wg.Done()
+	}()
+
+	for {
+		task, ok := exec.queue.Pop()
+		if !ok {
+			break
+		}
+		if exec.aborted.Load() {
+			task(ErrExecutorClosed)
+		} else {
+			task(nil)
+		}
+	}
+	returned = true
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixedExecutorNonPositivePanic(t *testing.T) {
+	require.PanicsWithError(t, "futures: number of workers must be a positive integer value but 0 is given", func() { NewFixedExecutor(0, 1) })
+	require.PanicsWithError(t, "futures: capacity must be a positive integer value but -1 is given", func() { NewFixedExecutor(1, -1) })
+}
+
+func TestSubmit(t *testing.T) {
+	exec := NewFixedExecutor(2, 4)
+	defer exec.Shutdown()
+
+	value, err := Submit(exec, func() (int, error) { return 42, nil }).Await()
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+
+	_, err = Submit(exec, func() (int, error) { panic("boom") }).Await()
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+
+	_, err = Submit(exec, func() (int, error) { runtime.Goexit(); return 0, nil }).Await()
+	require.ErrorIs(t, err, ErrGoexit)
+
+	value, err = Submit(exec, func() (int, error) { return 43, nil }).Await()
+	require.NoError(t, err)
+	require.Equal(t, 43, value)
+}
+
+func TestFixedExecutorShutdown(t *testing.T) {
+	exec := NewFixedExecutor(1, 8)
+	release := make(chan struct{})
+	var runs atomic.Int32
+	fn := func() (int, error) {
+		<-release
+		return int(runs.Add(1)), nil
+	}
+
+	var futures []*Future[int]
+	for i := 0; i < 4; i++ {
+		futures = append(futures, Submit(exec, fn))
+	}
+	close(release)
+	exec.Shutdown()
+	require.EqualValues(t, 4, runs.Load())
+	for i, future := range futures {
+		value, err := requireDone(t, future)
+		require.NoError(t, err)
+		require.Equal(t, i+1, value)
+	}
+
+	_, err := requireDone(t, Submit(exec, fn))
+	require.ErrorIs(t, err, ErrExecutorClosed)
+	require.ErrorIs(t, exec.Execute(func() {}), ErrExecutorClosed)
+	require.NotPanics(t, exec.Shutdown)
+}
+
+func TestFixedExecutorShutdownNow(t *testing.T) {
+	exec := NewFixedExecutor(1, 8)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	running := Submit(exec, func() (int, error) {
+		close(started)
+		<-release
+		return 42, nil
+	})
+	<-started
+	pending := Submit(exec, func() (int, error) { return 43, nil })
+	source := New[int]()
+	var called atomic.Bool
+	source.OnCompleteWith(exec, func(value int, err error) { called.Store(true) })
+	source.Complete(1)
+
+	shutdown := make(chan struct{})
+	go func() {
+		exec.ShutdownNow()
+		close(shutdown)
+	}()
+	require.Eventually(t, exec.aborted.Load, awaitTimeout, awaitTimeout/20)
+	requireNotDone(t, pending)
+	close(release)
+	<-shutdown
+
+	// Callbacks accepted by Execute are run anyway.
+	require.True(t, called.Load())
+	value, err := requireDone(t, running)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+	_, err = requireDone(t, pending)
+	require.ErrorIs(t, err, ErrExecutorClosed)
+}
+
+func TestSubmitCanceled(t *testing.T) {
+	exec := NewFixedExecutor(1, 8)
+	release := make(chan struct{})
+	Submit(exec, func() (int, error) {
+		<-release
+		return 0, nil
+	})
+	var called bool
+	future := Submit(exec, func() (int, error) {
+		called = true
+		return 0, nil
+	})
+	future.Cancel()
+	close(release)
+	exec.Shutdown()
+	require.False(t, called)
+}
+
+func TestSubmitCustomExecutor(t *testing.T) {
+	errRefused := errors.New("refused")
+	exec := ExecutorFunc(func(task func()) error { return errRefused })
+	_, err := requireDone(t, Submit(exec, func() (int, error) { return 42, nil }))
+	require.ErrorIs(t, err, errRefused)
+
+	value, err := requireDone(t, Submit(Inline, func() (int, error) { return 42, nil }))
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
@@ -5,7 +5,7 @@
 package futures
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"sync"
 )
@@ -16,11 +16,32 @@ type Future[V any] struct {
 	done  chan struct{}
 	error error
 	value V
+
+	// cancel cancels the producer's context if there's any.
+	cancel context.CancelFunc
+	// upstream is a list of futures this future is derived from.
+	upstream []upstreamRef
+	// consumers is the number of futures derived from this future.
+	consumers int
+	// shared is set if the future is handed to independent holders, e.g. by
+	// [Group.Do], so cancellation of derived futures is never propagated to it.
+	shared bool
+	// callbacks are invoked once the future is completed.
+	// They're referenced by pointers so they can be unsubscribed.
+	callbacks []*func()
+	// creation is set if leak detection is enabled.
+	creation *creation
+	// progress is the latest progress reported by the producer.
+	progress *Progress
+	// progressCallbacks are invoked on every progress update.
+	progressCallbacks []func(Progress)
 }
 
 // New creates a new incomplete future.
 func New[V any]() *Future[V] {
-	return &Future[V]{done: make(chan struct{})}
+	future := &Future[V]{done: make(chan struct{})}
+	future.track()
+	return future
 }
 
 // Done returns a channel that's closed when the future is completed.
@@ -31,15 +52,8 @@ func (future *Future[V]) Done() <-chan struct{} {
 // Complete sets a future value and marks it as completed.
 // This method should only be called once, subsequent calls will cause a panic.
 func (future *Future[V]) Complete(value V) {
-	future.mu.Lock()
-	defer future.mu.Unlock()
-
-	select {
-	case <-future.done:
+	if !future.settle(value, nil) {
 		panic("future result is already set")
-	default:
-		future.value = value
-		close(future.done)
 	}
 }
 
@@ -57,21 +71,41 @@ func (future *Future[V]) CompleteUntyped(untyped any) {
 // Fail completes a future with an error.
 // This method should only be called once, subsequent calls will cause a panic.
 func (future *Future[V]) Fail(err error) {
-	future.mu.Lock()
-	defer future.mu.Unlock()
-
-	select {
-	case <-future.done:
+	if err == nil {
+		panic("future error cannot be nil")
+	}
+	if !future.settle(future.makeEmpty(), err) {
 		panic("future result is already set")
-	default:
-		if err == nil {
-			panic("future error cannot be nil")
-		}
-		future.error = err
-		close(future.done)
 	}
 }
 
+// TryComplete attempts to set a future value and mark it as completed.
+// Unlike [Future.Complete] it doesn't panic if the future is already completed.
+// Return value is true if this call has completed the future.
+func (future *Future[V]) TryComplete(value V) (ok bool) {
+	return future.settle(value, nil)
+}
+
+// TryCompleteUntyped is similar to [Future.TryComplete] but accepts a value
+// of any type as a parameter and panics if it fails to convert to V.
+func (future *Future[V]) TryCompleteUntyped(untyped any) (ok bool) {
+	if value, ok := untyped.(V); ok {
+		return future.TryComplete(value)
+	} else {
+		panic(fmt.Errorf("unexpected future result: expected %T, got %T", value, untyped))
+	}
+}
+
+// TryFail attempts to complete a future with an error.
+// Unlike [Future.Fail] it doesn't panic if the future is already completed.
+// Return value is true if this call has completed the future.
+func (future *Future[V]) TryFail(err error) (ok bool) {
+	if err == nil {
+		panic("future error cannot be nil")
+	}
+	return future.settle(future.makeEmpty(), err)
+}
+
 // Get returns a future result and a completion status.
 func (future *Future[V]) Get() (value V, err error, completed bool) {
 	select {
@@ -82,20 +116,77 @@ func (future *Future[V]) Get() (value V, err error, completed bool) {
 	}
 }
 
+// Await blocks until the future is completed and returns its result.
+func (future *Future[V]) Await() (V, error) {
+	<-future.done
+	return future.get()
+}
+
+// AwaitWithContext is similar to [Future.Await] but can exit earlier
+// if ctx is canceled or deadlined.
+func (future *Future[V]) AwaitWithContext(ctx context.Context) (V, error) {
+	select {
+	case <-future.done:
+		return future.get()
+	case <-ctx.Done():
+		return future.makeEmpty(), ctx.Err()
+	}
+}
+
+// Reader returns a read-only view of the future. It's intended to be handed
+// to consumers so only the producer can complete the future.
+func (future *Future[V]) Reader() Reader[V] {
+	return Reader[V]{future: future}
+}
+
 // GetUntyped is similar [Future.Get] but returns a value of type any.
 // This method is intended to implement [UntypedInterface].
 func (future *Future[V]) GetUntyped() (value any, err error, completed bool) {
 	return future.Get()
 }
 
-// UnmarshalJSON implements a [json.Unmarshaler] interface.
-func (future *Future[V]) UnmarshalJSON(data []byte) error {
-	value := future.makeEmpty()
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
+// settle sets a future result unless it's already set. The value is
+// discarded if err is not nil.
+// Return value is false if the future was completed before.
+func (future *Future[V]) settle(value V, err error) (ok bool) {
+	if err != nil {
+		value = future.makeEmpty()
 	}
-	future.Complete(value)
-	return nil
+
+	future.mu.Lock()
+	select {
+	case <-future.done:
+		future.mu.Unlock()
+		return false
+	default:
+	}
+	future.value = value
+	future.error = err
+	close(future.done)
+	cancel, upstream, callbacks := future.cancel, future.upstream, future.callbacks
+	future.upstream, future.callbacks, future.progressCallbacks = nil, nil, nil
+	future.mu.Unlock()
+
+	future.untrack()
+
+	// A completed future needs neither the producer
+	// nor the futures it was derived from.
+	if cancel != nil {
+		cancel()
+	}
+	for _, ref := range upstream {
+		ref.source.release(ref.propagate)
+	}
+
+	for _, callback := range callbacks {
+		invokeCallback(*callback)
+	}
+	return true
+}
+
+// get returns a future result assuming it's already completed.
+func (future *Future[V]) get() (V, error) {
+	return future.value, future.error
 }
 
 func (future *Future[V]) makeEmpty() V {
@@ -5,8 +5,12 @@
 package futures
 
 import (
-	"testing"
+	"context"
 	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
@@ -73,3 +77,84 @@ func TestUntypedInterface(t *testing.T) {
 	require.Implements(t, (*UntypedInterface)(nil), New[any]())
 	require.Implements(t, (*UntypedInterface)(nil), NewUntyped())
 }
+
+func TestAwait(t *testing.T) {
+	future := New[int]()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		future.Complete(42)
+	}()
+	value, err := future.Await()
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
+
+func TestAwaitWithContext(t *testing.T) {
+	future := New[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := future.AwaitWithContext(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	future.Fail(errors.New("test"))
+	_, err = future.AwaitWithContext(context.Background())
+	require.EqualError(t, err, "test")
+}
+
+func TestTryComplete(t *testing.T) {
+	future := New[int]()
+	require.True(t, future.TryComplete(42))
+	require.False(t, future.TryComplete(43))
+	require.False(t, future.TryFail(errors.New("test")))
+	require.Panics(t, func() { future.Complete(44) })
+
+	value, err, ok := future.Get()
+	require.Equal(t, 42, value)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestTryFail(t *testing.T) {
+	future := New[int]()
+	require.PanicsWithValue(t, "future error cannot be nil", func() { future.TryFail(nil) })
+	require.True(t, future.TryFail(errors.New("test")))
+	require.False(t, future.TryFail(errors.New("another")))
+	require.False(t, future.TryComplete(42))
+
+	_, err, ok := future.Get()
+	require.EqualError(t, err, "test")
+	require.True(t, ok)
+}
+
+func TestTryCompleteRacing(t *testing.T) {
+	future := New[int]()
+	var wg sync.WaitGroup
+	var winners atomic.Int32
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if future.TryComplete(i) {
+				winners.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	require.EqualValues(t, 1, winners.Load())
+}
+
+func TestUntypedTryComplete(t *testing.T) {
+	untyped := NewUntyped()
+	require.True(t, untyped.TryCompleteUntyped("foo"))
+	require.False(t, untyped.TryCompleteUntyped("bar"))
+	require.False(t, untyped.TryFail(errors.New("test")))
+
+	value, err, ok := untyped.GetUntyped()
+	require.Equal(t, "foo", value)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	typed := New[int]()
+	require.Panics(t, func() { typed.TryCompleteUntyped("foo") })
+	require.True(t, typed.TryCompleteUntyped(42))
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// ErrGoexit is an error of a future whose producer goroutine
+// was terminated by [runtime.Goexit].
+var ErrGoexit = errors.New("futures: runtime.Goexit was called")
+
+// PanicError is an error of a future whose producer function panicked.
+type PanicError struct {
+	// Value is a value recovered from the panic.
+	Value any
+	// Stack is a stack trace of the panicked goroutine.
+	Stack []byte
+}
+
+// Error implements an error interface.
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("futures: panic: %v\n\n%s", err.Value, err.Stack)
+}
+
+// Unwrap returns the recovered value if it's an error.
+func (err *PanicError) Unwrap() error {
+	if unwrapped, ok := err.Value.(error); ok {
+		return unwrapped
+	}
+	return nil
+}
+
+// Go runs a function in a new goroutine and returns a future of its result.
+// If the function panics, the future fails with a [*PanicError].
+func Go[V any](fn func() (V, error)) *Future[V] {
+	future := New[V]()
+	go run(future, fn)
+	return future
+}
+
+// run calls a function and completes the future with its result.
+func run[V any](future *Future[V], fn func() (V, error)) {
+	var returned bool
+	defer func() {
+		if returned {
+			return
+		}
+		if value := recover(); value != nil {
+			future.TryFail(&PanicError{Value: value, Stack: debug.Stack()})
+		} else {
+			future.TryFail(ErrGoexit)
+		}
+	}()
+
+	value, err := fn()
+	returned = true
+	future.settle(value, err)
+}
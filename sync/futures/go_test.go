@@ -0,0 +1,43 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGo(t *testing.T) {
+	value, err := Go(func() (int, error) { return 42, nil }).Await()
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+
+	errTest := errors.New("test")
+	_, err = Go(func() (int, error) { return 0, errTest }).Await()
+	require.ErrorIs(t, err, errTest)
+}
+
+func TestGoPanic(t *testing.T) {
+	_, err := Go(func() (int, error) { panic("boom") }).Await()
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+	require.Equal(t, "boom", panicErr.Value)
+	require.Contains(t, string(panicErr.Stack), "TestGoPanic")
+	require.Contains(t, err.Error(), "futures: panic: boom")
+}
+
+func TestGoPanicWithError(t *testing.T) {
+	errTest := errors.New("test")
+	_, err := Go(func() (int, error) { panic(errTest) }).Await()
+	require.ErrorIs(t, err, errTest)
+}
+
+func TestGoexit(t *testing.T) {
+	_, err := Go(func() (int, error) { runtime.Goexit(); return 0, nil }).Await()
+	require.ErrorIs(t, err, ErrGoexit)
+}
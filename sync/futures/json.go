@@ -0,0 +1,162 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrUnmarshalCompleted is returned when unmarshalling JSON into a future
+// that is already completed.
+var ErrUnmarshalCompleted = errors.New("futures: unmarshal into a completed future")
+
+// Status values of a future JSON representation.
+const (
+	StatusPending   = "pending"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+// JSONError is a serializable representation of a future failure.
+// Failed futures restored from JSON hold errors of this type.
+type JSONError struct {
+	// Message is a text of the original error.
+	Message string `json:"message"`
+	// Details is a JSON representation of the original error
+	// if it implements a [json.Marshaler] interface.
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// Error implements an error interface.
+func (err *JSONError) Error() string {
+	return err.Message
+}
+
+// futureJSON is a JSON representation of a future. The status is stored
+// under a reserved key so it's never confused with a plain value.
+type futureJSON[V any] struct {
+	Status   string     `json:"$future"`
+	Value    *V         `json:"value,omitempty"`
+	Error    *JSONError `json:"error,omitempty"`
+	Progress *Progress  `json:"progress,omitempty"`
+}
+
+// MarshalJSON implements a [json.Marshaler] interface.
+// A future is represented by an object with a reserved "$future" field holding
+// a status which is one of "pending", "completed" or "failed", e.g.
+// {"$future":"completed","value":42}. A completed future also has a "value"
+// field and a failed future has an "error" field holding a [JSONError].
+// The latest reported [Progress] is stored in a "progress" field.
+func (future *Future[V]) MarshalJSON() ([]byte, error) {
+	var envelope futureJSON[V]
+	if progress, ok := future.Progress(); ok {
+		envelope.Progress = &progress
+	}
+	value, err, completed := future.Get()
+	switch {
+	case !completed:
+		envelope.Status = StatusPending
+	case err != nil:
+		envelope.Status = StatusFailed
+		envelope.Error, err = newJSONError(err)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		envelope.Status = StatusCompleted
+		envelope.Value = &value
+	}
+	return json.Marshal(envelope)
+}
+
+// UnmarshalJSON implements a [json.Unmarshaler] interface.
+// It accepts the representation produced by [Future.MarshalJSON] and
+// restores the future state accordingly. Any JSON without a "$future" field
+// is treated as a plain value the future is completed with.
+func (future *Future[V]) UnmarshalJSON(data []byte) error {
+	select {
+	case <-future.done:
+		return ErrUnmarshalCompleted
+	default:
+	}
+
+	envelope, ok, err := parseFutureJSON[V](data)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if envelope.Progress != nil {
+			future.SetProgress(*envelope.Progress)
+		}
+		switch envelope.Status {
+		case StatusFailed:
+			return future.unmarshalSettle(future.makeEmpty(), envelope.Error)
+		case StatusCompleted:
+			value := future.makeEmpty()
+			if envelope.Value != nil {
+				value = *envelope.Value
+			}
+			return future.unmarshalSettle(value, nil)
+		}
+		return nil
+	}
+
+	value := future.makeEmpty()
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return future.unmarshalSettle(value, nil)
+}
+
+func (future *Future[V]) unmarshalSettle(value V, err error) error {
+	if !future.settle(value, err) {
+		return ErrUnmarshalCompleted
+	}
+	return nil
+}
+
+// parseFutureJSON attempts to parse the representation produced by
+// [Future.MarshalJSON]. Return value ok is false if data is not an object
+// with a "$future" field. The envelope is validated as a whole, so nothing
+// is applied to the future unless it's valid.
+func parseFutureJSON[V any](data []byte) (envelope futureJSON[V], ok bool, err error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return envelope, false, nil
+	}
+	if _, ok := fields["$future"]; !ok {
+		return envelope, false, nil
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return envelope, false, err
+	}
+	switch envelope.Status {
+	case StatusPending, StatusCompleted:
+	case StatusFailed:
+		if envelope.Error == nil {
+			return envelope, false, fmt.Errorf("futures: failed future must have an error")
+		}
+	default:
+		return envelope, false, fmt.Errorf("futures: unknown future status %q", envelope.Status)
+	}
+	return envelope, true, nil
+}
+
+func newJSONError(err error) (*JSONError, error) {
+	if jsonErr, ok := err.(*JSONError); ok {
+		return jsonErr, nil
+	}
+	jsonErr := &JSONError{Message: err.Error()}
+	if marshaler, ok := err.(json.Marshaler); ok {
+		details, err := marshaler.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		jsonErr.Details = details
+	}
+	return jsonErr, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type detailedError struct {
+	Code int `json:"code"`
+}
+
+func (err detailedError) Error() string {
+	return "detailed"
+}
+
+func (err detailedError) MarshalJSON() ([]byte, error) {
+	type plain detailedError
+	return json.Marshal(plain(err))
+}
+
+func TestMarshalJSON(t *testing.T) {
+	future := New[int]()
+	data, err := json.Marshal(future)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"$future":"pending"}`, string(data))
+
+	future.Complete(42)
+	data, err = json.Marshal(future)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"$future":"completed","value":42}`, string(data))
+
+	future = New[int]()
+	future.Fail(errors.New("test"))
+	data, err = json.Marshal(future)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"$future":"failed","error":{"message":"test"}}`, string(data))
+
+	future = New[int]()
+	future.Fail(detailedError{Code: 42})
+	data, err = json.Marshal(future)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"$future":"failed","error":{"message":"detailed","details":{"code":42}}}`, string(data))
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	type job struct {
+		ID     int             `json:"id"`
+		Result *Future[string] `json:"result"`
+	}
+
+	for _, setup := range []func(*Future[string]){
+		func(future *Future[string]) {},
+		func(future *Future[string]) { future.Complete("foo") },
+		func(future *Future[string]) { future.Fail(errors.New("test")) },
+	} {
+		original := job{ID: 1, Result: New[string]()}
+		setup(original.Result)
+		data, err := json.Marshal(original)
+		require.NoError(t, err)
+
+		restored := job{Result: New[string]()}
+		require.NoError(t, json.Unmarshal(data, &restored))
+		require.Equal(t, original.ID, restored.ID)
+
+		value, err, completed := original.Result.Get()
+		restoredValue, restoredErr, restoredCompleted := restored.Result.Get()
+		require.Equal(t, completed, restoredCompleted)
+		require.Equal(t, value, restoredValue)
+		if err != nil {
+			var jsonErr *JSONError
+			require.ErrorAs(t, restoredErr, &jsonErr)
+			require.Equal(t, err.Error(), jsonErr.Message)
+		} else {
+			require.NoError(t, restoredErr)
+		}
+	}
+}
+
+func TestUnmarshalJSONPlainValue(t *testing.T) {
+	type jobStatus struct {
+		Status string `json:"status"`
+	}
+	future := New[jobStatus]()
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"pending"}`), future))
+	value, err, ok := future.Get()
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.Equal(t, jobStatus{Status: "pending"}, value)
+}
+
+func TestUnmarshalJSONUnknownStatus(t *testing.T) {
+	future := New[int]()
+	require.Error(t, json.Unmarshal([]byte(`{"$future":"unknown"}`), future))
+	requireNotDone(t, future)
+
+	// An invalid envelope doesn't change the progress either.
+	require.Error(t, json.Unmarshal([]byte(`{"$future":"unknown","progress":{"fraction":0.5}}`), future))
+	require.Error(t, json.Unmarshal([]byte(`{"$future":"failed","progress":{"fraction":0.5}}`), future))
+	_, ok := future.Progress()
+	require.False(t, ok)
+	requireNotDone(t, future)
+}
+
+func TestUnmarshalJSONCompleted(t *testing.T) {
+	future := New[int]()
+	future.Complete(42)
+	require.ErrorIs(t, json.Unmarshal([]byte(`43`), future), ErrUnmarshalCompleted)
+}
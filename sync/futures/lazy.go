@@ -0,0 +1,119 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"context"
+	"sync"
+)
+
+// Lazy is a future whose computation is deferred until its result
+// is requested for the first time. The computation runs only once and
+// its result is shared by all the waiters.
+type Lazy[V any] struct {
+	once   sync.Once
+	fn     func() (V, error)
+	future *Future[V]
+}
+
+// NewLazy makes a new lazy future of the function result.
+// The function is run in a separate goroutine once the result is requested.
+// If the function panics, the future fails with a [*PanicError].
+func NewLazy[V any](fn func() (V, error)) *Lazy[V] {
+	return &Lazy[V]{fn: fn}
+}
+
+// Start starts the computation unless it's already started
+// and returns a future of its result.
+func (lazy *Lazy[V]) Start() *Future[V] {
+	lazy.once.Do(func() {
+		lazy.future = New[V]()
+		go run(lazy.future, lazy.fn)
+	})
+	return lazy.future
+}
+
+// Future is the same as [Lazy.Start]. It returns the underlying future
+// so it can be passed to combinators such as [All] or adapters such as
+// [WithTimeout].
+func (lazy *Lazy[V]) Future() *Future[V] {
+	return lazy.Start()
+}
+
+// Done starts the computation unless it's already started and returns
+// a channel that's closed when it's completed.
+func (lazy *Lazy[V]) Done() <-chan struct{} {
+	return lazy.Start().Done()
+}
+
+// Await starts the computation unless it's already started and blocks
+// until it's completed.
+func (lazy *Lazy[V]) Await() (V, error) {
+	return lazy.Start().Await()
+}
+
+// AwaitWithContext is similar to [Lazy.Await] but can exit earlier
+// if ctx is canceled or deadlined. The computation keeps running anyway.
+func (lazy *Lazy[V]) AwaitWithContext(ctx context.Context) (V, error) {
+	return lazy.Start().AwaitWithContext(ctx)
+}
+
+// Group deduplicates concurrent computations identified by keys.
+// While a computation for a key is in flight, all the callers requesting
+// the same key get the same future. Once the computation is completed,
+// the key is forgotten and the next call starts a new computation.
+//
+// The zero value of Group is ready to use.
+type Group[K comparable, V any] struct {
+	mu       sync.Mutex
+	inflight map[K]*Future[V]
+}
+
+// Do starts the computation for the key unless there's one already in flight
+// and returns a future of its result. The returned future is shared
+// by all the callers so canceling it affects all of them. However,
+// canceling the futures derived from it, e.g. using [Map], doesn't.
+// If the function panics, the future fails with a [*PanicError].
+func (group *Group[K, V]) Do(key K, fn func() (V, error)) *Future[V] {
+	group.mu.Lock()
+	if future, ok := group.inflight[key]; ok {
+		group.mu.Unlock()
+		return future
+	}
+	if group.inflight == nil {
+		group.inflight = make(map[K]*Future[V])
+	}
+	future := New[V]()
+	future.shared = true
+	group.inflight[key] = future
+	group.mu.Unlock()
+
+	// The key is forgotten before the future is completed, so a caller that
+	// has seen the result gets a new computation. The callback only handles
+	// the future being completed from outside, e.g. canceled.
+	future.subscribe(func() { group.forget(key, future) })
+	go run(future, func() (V, error) {
+		defer group.forget(key, future)
+		return fn()
+	})
+	return future
+}
+
+// Forget tells the group to forget about the key so the next call to
+// [Group.Do] starts a new computation even if the current one is still
+// in flight.
+func (group *Group[K, V]) Forget(key K) {
+	group.mu.Lock()
+	delete(group.inflight, key)
+	group.mu.Unlock()
+}
+
+func (group *Group[K, V]) forget(key K, future *Future[V]) {
+	group.mu.Lock()
+	if group.inflight[key] == future {
+		delete(group.inflight, key)
+	}
+	group.mu.Unlock()
+}
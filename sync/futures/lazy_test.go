@@ -0,0 +1,103 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLazy(t *testing.T) {
+	var calls atomic.Int32
+	lazy := NewLazy(func() (int, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return 42, nil
+	})
+	time.Sleep(10 * time.Millisecond)
+	require.Zero(t, calls.Load())
+
+	waiters := make([]*Future[int], 8)
+	for i := range waiters {
+		waiters[i] = Go(lazy.Await)
+	}
+	for _, waiter := range waiters {
+		value, err := requireDone(t, waiter)
+		require.NoError(t, err)
+		require.Equal(t, 42, value)
+	}
+	require.EqualValues(t, 1, calls.Load())
+	require.Same(t, lazy.Start(), lazy.Start())
+}
+
+func TestLazyFuture(t *testing.T) {
+	lazy := NewLazy(func() (int, error) { return 42, nil })
+	value, err := requireDone(t, WithTimeout(lazy.Future(), awaitTimeout))
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+	require.Same(t, lazy.Start(), lazy.Future())
+
+	select {
+	case <-lazy.Done():
+	default:
+		t.Fatal("lazy future is not done")
+	}
+}
+
+func TestLazyNoLeak(t *testing.T) {
+	setLeakDetection(t, true)
+	lazy := NewLazy(func() (int, error) { return 42, nil })
+	require.Empty(t, Leaks())
+	_, err := lazy.Await()
+	require.NoError(t, err)
+	require.Empty(t, Leaks())
+}
+
+func TestGroup(t *testing.T) {
+	var group Group[string, int]
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fn := func() (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	a := group.Do("foo", fn)
+	b := group.Do("foo", fn)
+	c := group.Do("bar", fn)
+	require.Same(t, a, b)
+	require.NotSame(t, a, c)
+
+	close(release)
+	value, err := requireDone(t, a)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+	requireDone(t, c)
+	require.EqualValues(t, 2, calls.Load())
+
+	d := group.Do("foo", fn)
+	require.NotSame(t, a, d)
+	requireDone(t, d)
+	require.EqualValues(t, 3, calls.Load())
+}
+
+func TestGroupForget(t *testing.T) {
+	var group Group[string, int]
+	release := make(chan struct{})
+	defer close(release)
+	fn := func() (int, error) {
+		<-release
+		return 42, nil
+	}
+
+	a := group.Do("foo", fn)
+	group.Forget("foo")
+	b := group.Do("foo", fn)
+	require.NotSame(t, a, b)
+}
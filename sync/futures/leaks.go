@@ -0,0 +1,162 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// LeakDetectionEnv is an environment variable that enables leak detection
+// when it's set to a non-empty value other than "0".
+//
+// When leak detection is enabled, every future made with [New] records a
+// stack trace of its creation, and futures that are never completed
+// are reported by [Leaks] and [VerifyTestMain]. It has a noticeable
+// overhead so it's intended for debugging and testing only.
+const LeakDetectionEnv = "DOPE_FUTURES_DEBUG"
+
+var leakDetection atomic.Bool
+
+func init() {
+	if value := os.Getenv(LeakDetectionEnv); value != "" && value != "0" {
+		leakDetection.Store(true)
+	}
+}
+
+// Leak describes a future that has never been completed.
+type Leak struct {
+	// Stack is a stack trace of a goroutine that created the future.
+	Stack string
+	// Collected is true if the future was garbage collected.
+	// Otherwise, it's still pending.
+	Collected bool
+}
+
+// String returns a human-readable description of the leak.
+func (leak Leak) String() string {
+	state := "pending"
+	if leak.Collected {
+		state = "garbage collected"
+	}
+	return fmt.Sprintf("future was never completed (%s), created at:\n%s", state, leak.Stack)
+}
+
+// creation is a record of a future created while leak detection is enabled.
+// It must not reference the future itself so the latter can be collected.
+type creation struct {
+	pcs []uintptr
+}
+
+var leaks struct {
+	mu        sync.Mutex
+	pending   map[*creation]struct{}
+	collected []*creation
+}
+
+// Leaks returns a list of futures that have never been completed so far.
+// It always returns nil unless leak detection is enabled.
+func Leaks() []Leak {
+	leaks.mu.Lock()
+	defer leaks.mu.Unlock()
+
+	var result []Leak
+	for _, c := range leaks.collected {
+		result = append(result, Leak{Stack: c.stack(), Collected: true})
+	}
+	for c := range leaks.pending {
+		result = append(result, Leak{Stack: c.stack()})
+	}
+	return result
+}
+
+// VerifyTestMain runs the tests and then reports the futures that have
+// never been completed while running them. It's intended to be called
+// from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		futures.VerifyTestMain(m)
+//	}
+//
+// If leak detection is not enabled using [LeakDetectionEnv], it simply
+// runs the tests.
+func VerifyTestMain(m interface{ Run() int }) {
+	os.Exit(verifyLeaks(m.Run(), os.Stderr))
+}
+
+func verifyLeaks(code int, w io.Writer) int {
+	if code != 0 || !leakDetection.Load() {
+		return code
+	}
+	// Let the runtime run cleanups of unreachable futures.
+	runtime.GC()
+	runtime.GC()
+
+	found := Leaks()
+	if len(found) == 0 {
+		return code
+	}
+	fmt.Fprintf(w, "futures: found %d leaked futures\n", len(found))
+	for _, leak := range found {
+		fmt.Fprintf(w, "\n%s", leak)
+	}
+	return 1
+}
+
+// track records a creation of the future if leak detection is enabled.
+func (future *Future[V]) track() {
+	if !leakDetection.Load() {
+		return
+	}
+	pcs := make([]uintptr, 32)
+	c := &creation{pcs: pcs[:runtime.Callers(3, pcs)]}
+	future.creation = c
+
+	leaks.mu.Lock()
+	if leaks.pending == nil {
+		leaks.pending = make(map[*creation]struct{})
+	}
+	leaks.pending[c] = struct{}{}
+	leaks.mu.Unlock()
+
+	runtime.AddCleanup(future, func(c *creation) {
+		leaks.mu.Lock()
+		if _, ok := leaks.pending[c]; ok {
+			delete(leaks.pending, c)
+			leaks.collected = append(leaks.collected, c)
+		}
+		leaks.mu.Unlock()
+	}, c)
+}
+
+// untrack tells that the future is completed so it's not leaked.
+func (future *Future[V]) untrack() {
+	if future.creation == nil {
+		return
+	}
+	leaks.mu.Lock()
+	delete(leaks.pending, future.creation)
+	leaks.mu.Unlock()
+}
+
+func (c *creation) stack() string {
+	var builder strings.Builder
+	frames := runtime.CallersFrames(c.pcs)
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" {
+			fmt.Fprintf(&builder, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			break
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setLeakDetection(t *testing.T, enabled bool) {
+	resetLeaks := func() {
+		leaks.mu.Lock()
+		leaks.pending, leaks.collected = nil, nil
+		leaks.mu.Unlock()
+	}
+	resetLeaks()
+	enabled = leakDetection.Swap(enabled)
+	t.Cleanup(func() {
+		leakDetection.Store(enabled)
+		resetLeaks()
+	})
+}
+
+func newLeakedFuture() {
+	New[int]()
+}
+
+func TestLeaks(t *testing.T) {
+	setLeakDetection(t, true)
+
+	completed := New[int]()
+	completed.Complete(42)
+	pending := New[int]()
+	require.Len(t, Leaks(), 1)
+	leak := Leaks()[0]
+	require.False(t, leak.Collected)
+	require.True(t, strings.HasPrefix(leak.Stack, "github.com/marshall-lee/dope/sync/futures.TestLeaks\n"))
+
+	newLeakedFuture()
+	require.Eventually(t, func() bool {
+		runtime.GC()
+		for _, leak := range Leaks() {
+			if leak.Collected {
+				return strings.Contains(leak.Stack, "newLeakedFuture")
+			}
+		}
+		return false
+	}, awaitTimeout, awaitTimeout/20)
+
+	pending.Complete(42)
+	require.Len(t, Leaks(), 1)
+}
+
+func TestVerifyLeaks(t *testing.T) {
+	setLeakDetection(t, false)
+	var out strings.Builder
+	require.Equal(t, 0, verifyLeaks(0, &out))
+	require.Equal(t, 3, verifyLeaks(3, &out))
+	require.Empty(t, out.String())
+
+	setLeakDetection(t, true)
+	require.Equal(t, 0, verifyLeaks(0, &out))
+	pending := New[int]()
+	require.Equal(t, 1, verifyLeaks(0, &out))
+	require.Contains(t, out.String(), "futures: found 1 leaked futures")
+	require.Contains(t, out.String(), "TestVerifyLeaks")
+	pending.Complete(42)
+}
+
+func TestLeakDetectionDisabled(t *testing.T) {
+	setLeakDetection(t, false)
+	future := New[int]()
+	require.Nil(t, future.creation)
+}
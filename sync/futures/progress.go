@@ -0,0 +1,60 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+// Progress is a snapshot of a progress of a long-running computation.
+type Progress struct {
+	// Fraction is a completed part of the work between 0 and 1.
+	Fraction float64 `json:"fraction"`
+	// Message is an optional human-readable description of the current step.
+	Message string `json:"message,omitempty"`
+}
+
+// SetProgress publishes a progress update. It's intended to be called by
+// the producer while the future is pending; updates made after the future
+// is completed are ignored. Registered progress callbacks are invoked
+// in the calling goroutine.
+func (future *Future[V]) SetProgress(progress Progress) {
+	future.mu.Lock()
+	select {
+	case <-future.done:
+		future.mu.Unlock()
+		return
+	default:
+	}
+	future.progress = &progress
+	callbacks := future.progressCallbacks
+	future.mu.Unlock()
+
+	for _, callback := range callbacks {
+		callback(progress)
+	}
+}
+
+// Progress returns the latest progress reported by the producer.
+// Return value ok is false if the progress was never reported.
+func (future *Future[V]) Progress() (progress Progress, ok bool) {
+	future.mu.Lock()
+	defer future.mu.Unlock()
+
+	if future.progress == nil {
+		return progress, false
+	}
+	return *future.progress, true
+}
+
+// OnProgress registers a callback which is invoked on every progress update
+// until the future is completed. The callback is invoked by the goroutine
+// that reports progress, so it's expected to be quick and non-blocking.
+func (future *Future[V]) OnProgress(callback func(Progress)) {
+	future.mu.Lock()
+	defer future.mu.Unlock()
+
+	select {
+	case <-future.done:
+	default:
+		future.progressCallbacks = append(future.progressCallbacks, callback)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgress(t *testing.T) {
+	future, reader := NewPromise[int]()
+	_, ok := reader.Progress()
+	require.False(t, ok)
+
+	var updates []Progress
+	reader.OnProgress(func(progress Progress) { updates = append(updates, progress) })
+	future.SetProgress(Progress{Fraction: 0.5, Message: "halfway"})
+	future.SetProgress(Progress{Fraction: 0.9})
+
+	progress, ok := reader.Progress()
+	require.True(t, ok)
+	require.Equal(t, Progress{Fraction: 0.9}, progress)
+
+	future.Complete(42)
+	future.SetProgress(Progress{Fraction: 1})
+	progress, _ = reader.Progress()
+	require.Equal(t, Progress{Fraction: 0.9}, progress)
+	require.Equal(t, []Progress{{Fraction: 0.5, Message: "halfway"}, {Fraction: 0.9}}, updates)
+}
+
+func TestProgressJSON(t *testing.T) {
+	future := New[int]()
+	future.SetProgress(Progress{Fraction: 0.25, Message: "downloading"})
+	data, err := json.Marshal(future)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"$future":"pending","progress":{"fraction":0.25,"message":"downloading"}}`, string(data))
+
+	restored := New[int]()
+	require.NoError(t, json.Unmarshal(data, restored))
+	_, _, completed := restored.Get()
+	require.False(t, completed)
+	progress, ok := restored.Progress()
+	require.True(t, ok)
+	require.Equal(t, Progress{Fraction: 0.25, Message: "downloading"}, progress)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import "context"
+
+// Reader is a read-only view of a [Future]. It only allows to wait for
+// and to get the result, so a consumer holding it cannot complete the future.
+type Reader[V any] struct {
+	future *Future[V]
+}
+
+// NewPromise creates a new incomplete future and returns it along with
+// its read-only view. The future is meant to be kept by the producer
+// while the reader is passed to the consumers.
+func NewPromise[V any]() (*Future[V], Reader[V]) {
+	future := New[V]()
+	return future, future.Reader()
+}
+
+// Done returns a channel that's closed when the future is completed.
+func (reader Reader[V]) Done() <-chan struct{} {
+	return reader.future.Done()
+}
+
+// Get returns a future result and a completion status.
+func (reader Reader[V]) Get() (value V, err error, completed bool) {
+	return reader.future.Get()
+}
+
+// Result returns a future result and a completion status.
+func (reader Reader[V]) Result() (result Result[V], completed bool) {
+	return reader.future.Result()
+}
+
+// Await blocks until the future is completed and returns its result.
+func (reader Reader[V]) Await() (V, error) {
+	return reader.future.Await()
+}
+
+// AwaitWithContext is similar to [Reader.Await] but can exit earlier
+// if ctx is canceled or deadlined.
+func (reader Reader[V]) AwaitWithContext(ctx context.Context) (V, error) {
+	return reader.future.AwaitWithContext(ctx)
+}
+
+// Progress returns the latest progress reported by the producer.
+func (reader Reader[V]) Progress() (progress Progress, ok bool) {
+	return reader.future.Progress()
+}
+
+// OnProgress registers a callback which is invoked on every progress update.
+func (reader Reader[V]) OnProgress(callback func(Progress)) {
+	reader.future.OnProgress(callback)
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromise(t *testing.T) {
+	future, reader := NewPromise[int]()
+	_, _, ok := reader.Get()
+	require.False(t, ok)
+
+	go future.Complete(42)
+	<-reader.Done()
+	value, err := reader.Await()
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+
+	value, err, ok = reader.Get()
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
+
+func TestUntypedReader(t *testing.T) {
+	untyped := NewUntyped()
+	reader := untyped.Reader()
+	untyped.CompleteUntyped("foo")
+	value, err := reader.Await()
+	require.NoError(t, err)
+	require.Equal(t, "foo", value)
+}
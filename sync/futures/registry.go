@@ -0,0 +1,156 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/marshall-lee/dope/opt"
+)
+
+// ErrExpired is an error of a future which was registered in a [Registry]
+// and was not completed within the configured timeout.
+var ErrExpired = errors.New("futures: registry entry expired")
+
+// Registry keeps track of pending futures identified by keys. It's intended
+// for request/response correlation in RPC-style protocols: a future is
+// registered when a request is sent and it's completed when a response
+// with the same key arrives.
+//
+// A future is removed from the registry as soon as it's completed
+// in any way, including [Future.Cancel].
+type Registry[K comparable, V any] struct {
+	mu      sync.Mutex
+	next    func() K
+	timeout time.Duration
+	pending map[K]*Future[V]
+}
+
+// RegistryOptions holds configuration of a [Registry].
+type RegistryOptions struct {
+	// Timeout is a duration after which a pending future fails with
+	// [ErrExpired]. Zero value means that futures never expire.
+	Timeout time.Duration
+}
+
+// ExpireAfter sets a timeout after which pending futures fail with [ErrExpired].
+func ExpireAfter(timeout time.Duration) opt.Setter[RegistryOptions] {
+	return opt.ApplyFunc(func(opts *RegistryOptions) {
+		opts.Timeout = timeout
+	})
+}
+
+// NewRegistry makes a new registry that issues keys using the next function.
+// The next function is called under the registry lock so it doesn't need
+// to be synchronized.
+func NewRegistry[K comparable, V any](next func() K, setters ...opt.Setter[RegistryOptions]) *Registry[K, V] {
+	var opts RegistryOptions
+	opt.Apply(&opts, setters...)
+	return &Registry[K, V]{
+		next:    next,
+		timeout: opts.Timeout,
+		pending: make(map[K]*Future[V]),
+	}
+}
+
+// Register issues a new key and returns it along with a new pending future
+// associated with it. The key function must not issue keys that are still
+// pending, otherwise this method panics.
+func (registry *Registry[K, V]) Register() (K, *Future[V]) {
+	key, future := registry.add()
+
+	var timer *time.Timer
+	if registry.timeout > 0 {
+		timer = time.AfterFunc(registry.timeout, func() {
+			registry.remove(key, future)
+			future.TryFail(ErrExpired)
+		})
+	}
+	// The registry removes the future itself before completing it.
+	// The callback only handles the future being completed from outside,
+	// e.g. canceled.
+	future.subscribe(func() {
+		if timer != nil {
+			timer.Stop()
+		}
+		registry.remove(key, future)
+	})
+	return key, future
+}
+
+// Complete completes a future associated with the key. Return value
+// is false if there's no pending future with such key.
+func (registry *Registry[K, V]) Complete(key K, value V) (ok bool) {
+	if future := registry.take(key); future != nil {
+		return future.TryComplete(value)
+	}
+	return false
+}
+
+// Fail fails a future associated with the key. Return value
+// is false if there's no pending future with such key.
+func (registry *Registry[K, V]) Fail(key K, err error) (ok bool) {
+	if future := registry.take(key); future != nil {
+		return future.TryFail(err)
+	}
+	return false
+}
+
+// FailAll fails all the pending futures with the error.
+// It's useful for example when the connection is lost.
+func (registry *Registry[K, V]) FailAll(err error) {
+	registry.mu.Lock()
+	pending := registry.pending
+	registry.pending = make(map[K]*Future[V])
+	registry.mu.Unlock()
+
+	for _, future := range pending {
+		future.TryFail(err)
+	}
+}
+
+// Len returns the number of pending futures.
+func (registry *Registry[K, V]) Len() int {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	return len(registry.pending)
+}
+
+// add issues a new key and associates it with a new future. The lock is
+// released even if the key function panics, and the future is only made
+// once the key is accepted.
+func (registry *Registry[K, V]) add() (K, *Future[V]) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	key := registry.next()
+	if _, ok := registry.pending[key]; ok {
+		panic(fmt.Errorf("futures: key %v is already registered", key))
+	}
+	future := New[V]()
+	future.shared = true
+	registry.pending[key] = future
+	return key, future
+}
+
+// take removes a future associated with the key and returns it.
+func (registry *Registry[K, V]) take(key K) *Future[V] {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	future := registry.pending[key]
+	delete(registry.pending, key)
+	return future
+}
+
+// remove removes the future unless the key is already reused.
+func (registry *Registry[K, V]) remove(key K, future *Future[V]) {
+	registry.mu.Lock()
+	if registry.pending[key] == future {
+		delete(registry.pending, key)
+	}
+	registry.mu.Unlock()
+}
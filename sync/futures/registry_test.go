@@ -0,0 +1,110 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newCounter() func() int {
+	var counter int
+	return func() int {
+		counter += 1
+		return counter
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry[int, string](newCounter())
+	key1, future1 := registry.Register()
+	key2, future2 := registry.Register()
+	require.NotEqual(t, key1, key2)
+	require.Equal(t, 2, registry.Len())
+
+	require.True(t, registry.Complete(key2, "foo"))
+	require.False(t, registry.Complete(key2, "bar"))
+	value, err := requireDone(t, future2)
+	require.NoError(t, err)
+	require.Equal(t, "foo", value)
+	require.Equal(t, 1, registry.Len())
+
+	errTest := errors.New("test")
+	require.True(t, registry.Fail(key1, errTest))
+	_, err = requireDone(t, future1)
+	require.ErrorIs(t, err, errTest)
+	require.Zero(t, registry.Len())
+
+	require.False(t, registry.Complete(42, "baz"))
+}
+
+func TestRegistryFailAll(t *testing.T) {
+	errLost := errors.New("connection lost")
+	registry := NewRegistry[int, string](newCounter())
+	_, future1 := registry.Register()
+	_, future2 := registry.Register()
+	registry.FailAll(errLost)
+	_, err := requireDone(t, future1)
+	require.ErrorIs(t, err, errLost)
+	_, err = requireDone(t, future2)
+	require.ErrorIs(t, err, errLost)
+	require.Zero(t, registry.Len())
+}
+
+func TestRegistryExpire(t *testing.T) {
+	registry := NewRegistry[int, string](newCounter(), ExpireAfter(10*time.Millisecond))
+	key, future := registry.Register()
+	_, err := requireDone(t, future)
+	require.ErrorIs(t, err, ErrExpired)
+	require.Zero(t, registry.Len())
+	require.False(t, registry.Complete(key, "foo"))
+}
+
+func TestRegistryCancel(t *testing.T) {
+	registry := NewRegistry[int, string](newCounter())
+	key, future := registry.Register()
+	future.Cancel()
+	require.Zero(t, registry.Len())
+	require.False(t, registry.Complete(key, "foo"))
+}
+
+func TestRegistryDuplicateKey(t *testing.T) {
+	registry := NewRegistry[int, string](func() int { return 42 })
+	registry.Register()
+	require.PanicsWithError(t, "futures: key 42 is already registered", func() { registry.Register() })
+}
+
+func TestRegistryDuplicateKeyNoLeak(t *testing.T) {
+	setLeakDetection(t, true)
+	registry := NewRegistry[int, string](func() int { return 42 })
+	_, future := registry.Register()
+	require.Panics(t, func() { registry.Register() })
+	require.Len(t, Leaks(), 1)
+	future.Cancel()
+	require.Empty(t, Leaks())
+}
+
+func TestRegistryKeyPanic(t *testing.T) {
+	next := newCounter()
+	var failed bool
+	registry := NewRegistry[int, string](func() int {
+		if !failed {
+			failed = true
+			panic("boom")
+		}
+		return next()
+	})
+	require.PanicsWithValue(t, "boom", func() { registry.Register() })
+
+	key, future := registry.Register()
+	require.Equal(t, 1, key)
+	require.True(t, registry.Complete(key, "foo"))
+	value, err := requireDone(t, future)
+	require.NoError(t, err)
+	require.Equal(t, "foo", value)
+}
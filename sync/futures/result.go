@@ -0,0 +1,68 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+// Result holds an outcome of a completed future.
+// A result with a non-nil Err is a failure.
+type Result[V any] struct {
+	Value V
+	Err   error
+}
+
+// ResultOf makes a result of a value and an error pair.
+// The value is discarded if the error is not nil.
+func ResultOf[V any](value V, err error) Result[V] {
+	if err != nil {
+		return Result[V]{Err: err}
+	}
+	return Result[V]{Value: value}
+}
+
+// Unpack returns the result as a value and an error pair.
+func (result Result[V]) Unpack() (V, error) {
+	return result.Value, result.Err
+}
+
+// Must returns the value if the error is nil and panics otherwise.
+// It's useful for example as futures.Must(future.Await()).
+func Must[V any](value V, err error) V {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Completed returns a future that is already completed with the result.
+func Completed[V any](result Result[V]) *Future[V] {
+	future := New[V]()
+	future.CompleteResult(result)
+	return future
+}
+
+// Result returns a future result and a completion status.
+func (future *Future[V]) Result() (result Result[V], completed bool) {
+	select {
+	case <-future.done:
+		return ResultOf(future.get()), true
+	default:
+		return result, false
+	}
+}
+
+// CompleteResult completes a future with the result value or fails it with
+// the result error. This method should only be called once, subsequent calls
+// will cause a panic.
+func (future *Future[V]) CompleteResult(result Result[V]) {
+	if !future.TryCompleteResult(result) {
+		panic("future result is already set")
+	}
+}
+
+// TryCompleteResult is similar to [Future.CompleteResult] but doesn't panic
+// if the future is already completed. Return value is true if this call
+// has completed the future.
+func (future *Future[V]) TryCompleteResult(result Result[V]) (ok bool) {
+	return future.settle(result.Unpack())
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultOf(t *testing.T) {
+	errTest := errors.New("test")
+	require.Equal(t, Result[int]{Value: 42}, ResultOf(42, nil))
+	require.Equal(t, Result[int]{Err: errTest}, ResultOf(42, errTest))
+
+	value, err := ResultOf(42, nil).Unpack()
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
+
+func TestMust(t *testing.T) {
+	errTest := errors.New("test")
+	require.Equal(t, 42, Must(Completed(ResultOf(42, nil)).Await()))
+	require.PanicsWithValue(t, errTest, func() { Must(Completed(ResultOf(0, errTest)).Await()) })
+}
+
+func TestFutureResult(t *testing.T) {
+	future, reader := NewPromise[int]()
+	_, ok := reader.Result()
+	require.False(t, ok)
+
+	future.CompleteResult(Result[int]{Value: 42})
+	result, ok := future.Result()
+	require.True(t, ok)
+	require.Equal(t, Result[int]{Value: 42}, result)
+	require.False(t, future.TryCompleteResult(Result[int]{Value: 43}))
+	require.Panics(t, func() { future.CompleteResult(Result[int]{Value: 43}) })
+
+	errTest := errors.New("test")
+	result, ok = Completed(Result[int]{Value: 42, Err: errTest}).Result()
+	require.True(t, ok)
+	require.Equal(t, Result[int]{Err: errTest}, result)
+}
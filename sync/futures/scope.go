@@ -0,0 +1,114 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"context"
+	"sync"
+)
+
+// Scope runs a group of child functions and ensures that none of them
+// outlives the scope. Each child gets a context derived from the scope
+// context, and the first child failure cancels it so the siblings are
+// told to stop.
+//
+// A Scope must not be copied after first use.
+type Scope struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+	sem    chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// NewScope makes a new scope with a context derived from ctx.
+func NewScope(ctx context.Context) *Scope {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Scope{ctx: ctx, cancel: cancel}
+}
+
+// Context returns the scope context. It's canceled either when some
+// child fails or when [Scope.Wait] returns.
+func (scope *Scope) Context() context.Context {
+	return scope.ctx
+}
+
+// SetLimit limits the number of concurrently running children.
+// A negative or zero value means no limit.
+// It must not be called while there are running children.
+func (scope *Scope) SetLimit(n int) {
+	if n <= 0 {
+		scope.sem = nil
+	} else {
+		scope.sem = make(chan struct{}, n)
+	}
+}
+
+// Spawn runs a child function in a new goroutine and returns a future of its result.
+// If the limit of concurrently running children is reached, it blocks until
+// some of them finishes. Canceling the returned future cancels the child context
+// and isn't considered a child failure, so the siblings keep running.
+// If the function panics, the future fails with a [*PanicError].
+func Spawn[V any](scope *Scope, fn func(ctx context.Context) (V, error)) *Future[V] {
+	if scope.sem != nil {
+		scope.sem <- struct{}{}
+	}
+	scope.wg.Add(1)
+
+	ctx, cancel := context.WithCancel(scope.ctx)
+	future := New[V]()
+	future.cancel = cancel
+	go func() {
+		// Whether the future was completed by its consumer, e.g. canceled,
+		// before the child has returned.
+		var external bool
+		defer func() {
+			if _, err := future.get(); err != nil && !external {
+				scope.fail(err)
+			}
+			if scope.sem != nil {
+				<-scope.sem
+			}
+			scope.wg.Done()
+		}()
+		run(future, func() (V, error) {
+			defer func() {
+				select {
+				case <-future.done:
+					external = true
+				default:
+				}
+			}()
+			return fn(ctx)
+		})
+	}()
+	return future
+}
+
+// Wait blocks until all the children are finished and returns the first
+// child failure if there's any. The scope context is canceled afterwards.
+func (scope *Scope) Wait() error {
+	scope.wg.Wait()
+	scope.cancel(context.Canceled)
+
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	return scope.err
+}
+
+func (scope *Scope) fail(err error) {
+	scope.mu.Lock()
+	first := scope.err == nil
+	if first {
+		scope.err = err
+	}
+	scope.mu.Unlock()
+
+	if first {
+		scope.cancel(err)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScope(t *testing.T) {
+	scope := NewScope(context.Background())
+	a := Spawn(scope, func(ctx context.Context) (int, error) { return 42, nil })
+	b := Spawn(scope, func(ctx context.Context) (string, error) {
+		time.Sleep(10 * time.Millisecond)
+		return "foo", nil
+	})
+	require.NoError(t, scope.Wait())
+
+	value, err, ok := a.Get()
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+	str, err, ok := b.Get()
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.Equal(t, "foo", str)
+	require.Error(t, scope.Context().Err())
+}
+
+func TestScopeFailure(t *testing.T) {
+	errTest := errors.New("test")
+	scope := NewScope(context.Background())
+	sibling := Spawn(scope, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	Spawn(scope, func(ctx context.Context) (int, error) { return 0, errTest })
+
+	require.ErrorIs(t, scope.Wait(), errTest)
+	_, err, ok := sibling.Get()
+	require.True(t, ok)
+	require.ErrorIs(t, err, context.Canceled)
+	require.ErrorIs(t, context.Cause(scope.Context()), errTest)
+}
+
+func TestScopePanic(t *testing.T) {
+	scope := NewScope(context.Background())
+	Spawn(scope, func(ctx context.Context) (int, error) { panic("boom") })
+	var panicErr *PanicError
+	require.ErrorAs(t, scope.Wait(), &panicErr)
+}
+
+func TestScopeLimit(t *testing.T) {
+	scope := NewScope(context.Background())
+	scope.SetLimit(2)
+	var running, maxRunning atomic.Int32
+	for i := 0; i < 8; i++ {
+		Spawn(scope, func(ctx context.Context) (int, error) {
+			n := running.Add(1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+			return i, nil
+		})
+	}
+	require.NoError(t, scope.Wait())
+	require.EqualValues(t, 2, maxRunning.Load())
+}
+
+func TestScopeWaitsForCanceledChild(t *testing.T) {
+	scope := NewScope(context.Background())
+	var finished atomic.Bool
+	child := Spawn(scope, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		finished.Store(true)
+		return 0, ctx.Err()
+	})
+	sibling := Spawn(scope, func(ctx context.Context) (int, error) {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(awaitTimeout / 4):
+			return 42, nil
+		}
+	})
+	child.Cancel()
+	require.NoError(t, scope.Wait())
+	require.True(t, finished.Load())
+
+	// Canceling a child is not a failure, so the siblings aren't canceled.
+	value, err := requireDone(t, sibling)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+
+	internal "github.com/marshall-lee/dope/internal/queues"
+)
+
+var (
+	// ErrCloseClosed is a panic value of closing a [Stream] that is already closed.
+	ErrCloseClosed = errors.New("futures: close of a closed stream")
+	// ErrSendClosed is a panic value of sending to a [Stream] that is closed.
+	ErrSendClosed = errors.New("futures: send to a closed stream")
+)
+
+// Stream is a multi-value counterpart of a [Future]. A producer sends a
+// sequence of values to the stream and then closes it, possibly with
+// an error.
+//
+// Every subscriber independently receives all the values sent after it has
+// subscribed. Each subscriber has its own buffer of a fixed capacity and
+// the producer blocks while any of the buffers is full.
+type Stream[V any] struct {
+	// sendMu serializes senders so all the subscribers receive
+	// the values in the same order.
+	sendMu      sync.Mutex
+	mu          sync.Mutex
+	cap         int
+	subscribers map[*Subscription[V]]struct{}
+	closed      bool
+	err         error
+}
+
+// Subscription is a consumer side of a [Stream].
+type Subscription[V any] struct {
+	stream *Stream[V]
+	queue  internal.BlockingBounded[V]
+}
+
+// NewStream makes a new stream with a given capacity of subscribers buffers.
+// Capacity is expected to be a positive non-zero integer value, otherwise
+// this function panics.
+func NewStream[V any](cap int) *Stream[V] {
+	if cap <= 0 {
+		panic(fmt.Errorf("futures: capacity must be a positive integer value but %v is given", cap))
+	}
+	return &Stream[V]{cap: cap, subscribers: make(map[*Subscription[V]]struct{})}
+}
+
+// Send sends a value to all the current subscribers. If there are no
+// subscribers, the value is discarded. This method blocks while the buffer
+// of any subscriber is full and panics if the stream is closed.
+// Concurrent calls are serialized, so all the subscribers receive
+// the values in the same order.
+func (stream *Stream[V]) Send(value V) {
+	stream.sendMu.Lock()
+	defer stream.sendMu.Unlock()
+
+	stream.mu.Lock()
+	if stream.closed {
+		stream.mu.Unlock()
+		panic(ErrSendClosed)
+	}
+	subscribers := make([]*Subscription[V], 0, len(stream.subscribers))
+	for sub := range stream.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	stream.mu.Unlock()
+
+	for _, sub := range subscribers {
+		// Push fails only when the subscriber is gone.
+		sub.queue.Push(value)
+	}
+}
+
+// Close closes the stream. Subscribers receive the remaining buffered
+// values and then their iteration is finished.
+// Calling this method twice on the same stream results in panic.
+func (stream *Stream[V]) Close() {
+	stream.close(nil)
+}
+
+// CloseWithError is similar to [Stream.Close] but the subscribers
+// receive the error after the remaining buffered values.
+func (stream *Stream[V]) CloseWithError(err error) {
+	if err == nil {
+		panic("stream error cannot be nil")
+	}
+	stream.close(err)
+}
+
+// Subscribe makes a new subscription to the stream values. If the stream
+// is already closed, the subscription only receives the stream error if
+// there's any.
+//
+// A subscription that is no longer needed must be canceled using
+// [Subscription.Unsubscribe] because otherwise it eventually blocks
+// the producer.
+func (stream *Stream[V]) Subscribe() *Subscription[V] {
+	sub := &Subscription[V]{stream: stream}
+	sub.queue.Init(stream.cap)
+
+	stream.mu.Lock()
+	if stream.closed {
+		sub.queue.Close()
+	} else {
+		stream.subscribers[sub] = struct{}{}
+	}
+	stream.mu.Unlock()
+	return sub
+}
+
+// All returns an iterator over the received values. If the stream was closed
+// with an error, the last pair holds the error and an empty value.
+// Breaking the iteration cancels the subscription.
+func (sub *Subscription[V]) All() iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for {
+			value, ok := sub.queue.Pop()
+			if !ok {
+				break
+			}
+			if !yield(value, nil) {
+				sub.Unsubscribe()
+				return
+			}
+		}
+
+		sub.stream.mu.Lock()
+		err := sub.stream.err
+		sub.stream.mu.Unlock()
+		if err != nil {
+			var empty V
+			yield(empty, err)
+		}
+	}
+}
+
+// Unsubscribe cancels the subscription so it no longer receives values.
+func (sub *Subscription[V]) Unsubscribe() {
+	sub.stream.mu.Lock()
+	delete(sub.stream.subscribers, sub)
+	sub.stream.mu.Unlock()
+	sub.queue.Close()
+}
+
+func (stream *Stream[V]) close(err error) {
+	stream.mu.Lock()
+	if stream.closed {
+		stream.mu.Unlock()
+		panic(ErrCloseClosed)
+	}
+	stream.closed = true
+	stream.err = err
+	subscribers := stream.subscribers
+	stream.subscribers = nil
+	stream.mu.Unlock()
+
+	for sub := range subscribers {
+		sub.queue.Close()
+	}
+}
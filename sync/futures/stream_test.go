@@ -0,0 +1,151 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"iter"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type streamItem struct {
+	value int
+	err   error
+}
+
+func collect(sub *Subscription[int]) *Future[[]streamItem] {
+	return Go(func() ([]streamItem, error) {
+		var items []streamItem
+		for value, err := range sub.All() {
+			items = append(items, streamItem{value, err})
+		}
+		return items, nil
+	})
+}
+
+func TestNewStreamNonPositiveCapPanic(t *testing.T) {
+	require.PanicsWithError(t, "futures: capacity must be a positive integer value but 0 is given", func() { NewStream[int](0) })
+}
+
+func TestStream(t *testing.T) {
+	stream := NewStream[int](2)
+	stream.Send(0)
+	a := collect(stream.Subscribe())
+	b := collect(stream.Subscribe())
+	for i := 1; i <= 5; i++ {
+		stream.Send(i)
+	}
+	stream.Close()
+
+	expected := []streamItem{{1, nil}, {2, nil}, {3, nil}, {4, nil}, {5, nil}}
+	items, err := requireDone(t, a)
+	require.NoError(t, err)
+	require.Equal(t, expected, items)
+	items, err = requireDone(t, b)
+	require.NoError(t, err)
+	require.Equal(t, expected, items)
+
+	require.PanicsWithValue(t, ErrSendClosed, func() { stream.Send(6) })
+	require.PanicsWithValue(t, ErrCloseClosed, func() { stream.Close() })
+}
+
+func TestStreamCloseWithError(t *testing.T) {
+	errTest := errors.New("test")
+	stream := NewStream[int](4)
+	sub := stream.Subscribe()
+	stream.Send(1)
+	stream.CloseWithError(errTest)
+	items, err := requireDone(t, collect(sub))
+	require.NoError(t, err)
+	require.Equal(t, []streamItem{{1, nil}, {0, errTest}}, items)
+
+	items, err = requireDone(t, collect(stream.Subscribe()))
+	require.NoError(t, err)
+	require.Equal(t, []streamItem{{0, errTest}}, items)
+}
+
+func TestStreamBackpressure(t *testing.T) {
+	stream := NewStream[int](1)
+	sub := stream.Subscribe()
+	stream.Send(1)
+	sent := Go(func() (struct{}, error) {
+		stream.Send(2)
+		return struct{}{}, nil
+	})
+	requireNotDone(t, sent)
+
+	// Reading a value makes a room while staying subscribed.
+	next, stop := iter.Pull2(sub.All())
+	value, err, ok := next()
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.Equal(t, 1, value)
+	requireDone(t, sent)
+	value, err, ok = next()
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.Equal(t, 2, value)
+
+	stop()
+	stream.Send(3)
+	stream.Close()
+}
+
+func TestStreamUnsubscribe(t *testing.T) {
+	stream := NewStream[int](1)
+	sub := stream.Subscribe()
+	stream.Send(1)
+	sent := Go(func() (struct{}, error) {
+		stream.Send(2)
+		return struct{}{}, nil
+	})
+	time.Sleep(10 * time.Millisecond)
+	sub.Unsubscribe()
+	requireDone(t, sent)
+	stream.Send(3)
+	stream.Close()
+}
+
+func TestStreamConcurrentSendOrder(t *testing.T) {
+	stream := NewStream[int](4)
+	a, b := stream.Subscribe(), stream.Subscribe()
+	collect := func(sub *Subscription[int]) *Future[[]int] {
+		return Go(func() ([]int, error) {
+			var values []int
+			for value, err := range sub.All() {
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			}
+			return values, nil
+		})
+	}
+	aValues, bValues := collect(a), collect(b)
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				stream.Send(i*100 + j)
+			}
+		}()
+	}
+	wg.Wait()
+	stream.Close()
+
+	aResult, err := requireDone(t, aValues)
+	require.NoError(t, err)
+	bResult, err := requireDone(t, bValues)
+	require.NoError(t, err)
+	require.Len(t, aResult, 800)
+	require.Equal(t, aResult, bResult)
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTimeout is an error of futures made with [After], [WithDeadline]
+// and [WithTimeout] when the time is up.
+var ErrTimeout = errors.New("futures: timeout")
+
+// After returns a future that fails with [ErrTimeout] after the duration.
+// It's useful for example with [Race]. Completing or canceling the future
+// earlier stops the underlying timer.
+func After[V any](d time.Duration) *Future[V] {
+	future := New[V]()
+	timer := time.AfterFunc(d, func() { future.TryFail(ErrTimeout) })
+	future.subscribe(func() { timer.Stop() })
+	return future
+}
+
+// Delay returns a future that is completed with the value after the duration.
+// Completing or canceling the future earlier stops the underlying timer.
+func Delay[V any](d time.Duration, value V) *Future[V] {
+	future := New[V]()
+	timer := time.AfterFunc(d, func() { future.TryComplete(value) })
+	future.subscribe(func() { timer.Stop() })
+	return future
+}
+
+// WithDeadline returns a future that is completed in the same way as the
+// given future unless it's not completed until the deadline. Otherwise,
+// the returned future fails with [ErrTimeout]. The underlying timer is
+// stopped and the given future is unsubscribed from as soon as the returned
+// future is completed.
+//
+// The given future is left intact on timeout since it may be shared
+// with other consumers. Use [Future.Cancel] to cancel it explicitly.
+func WithDeadline[V any](future *Future[V], deadline time.Time) *Future[V] {
+	result := New[V]()
+	result.hold(future)
+	timer := time.AfterFunc(time.Until(deadline), func() { result.TryFail(ErrTimeout) })
+	unsubscribe := future.subscribe(func() { result.settle(future.get()) })
+	result.subscribe(func() {
+		timer.Stop()
+		unsubscribe()
+	})
+	return result
+}
+
+// WithTimeout is similar to [WithDeadline] but accepts a duration
+// relative to the current time.
+func WithTimeout[V any](future *Future[V], timeout time.Duration) *Future[V] {
+	return WithDeadline(future, time.Now().Add(timeout))
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Vladimir Kochnev. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package futures
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAfter(t *testing.T) {
+	start := time.Now()
+	_, err := After[int](20 * time.Millisecond).Await()
+	require.ErrorIs(t, err, ErrTimeout)
+	require.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+func TestAfterRace(t *testing.T) {
+	future := New[int]()
+	timeout := After[int](time.Hour)
+	race := Race(future, timeout)
+	future.Complete(42)
+	value, err := requireDone(t, race)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+
+	timeout.Cancel()
+	_, err = requireDone(t, timeout)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDelay(t *testing.T) {
+	start := time.Now()
+	value, err := Delay(20*time.Millisecond, 42).Await()
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+	require.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+func TestWithDeadline(t *testing.T) {
+	future := New[int]()
+	result := WithDeadline(future, time.Now().Add(time.Hour))
+	future.Complete(42)
+	value, err := requireDone(t, result)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
+
+func TestWithTimeoutExpired(t *testing.T) {
+	source := GoContext(context.Background(), func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	_, err := requireDone(t, WithTimeout(source, 10*time.Millisecond))
+	require.ErrorIs(t, err, ErrTimeout)
+	requireNotDone(t, source)
+	source.Cancel()
+}
+
+func TestWithTimeoutShared(t *testing.T) {
+	var group Group[string, int]
+	release := make(chan struct{})
+	shared := group.Do("key", func() (int, error) {
+		<-release
+		return 42, nil
+	})
+	other := group.Do("key", func() (int, error) { return 0, nil })
+
+	_, err := requireDone(t, WithTimeout(shared, 10*time.Millisecond))
+	require.ErrorIs(t, err, ErrTimeout)
+	close(release)
+	value, err := requireDone(t, other)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
+
+func TestWithTimeoutUnsubscribes(t *testing.T) {
+	source := New[int]()
+	for range 10 {
+		_, err := requireDone(t, WithTimeout(source, time.Millisecond))
+		require.ErrorIs(t, err, ErrTimeout)
+	}
+	source.mu.Lock()
+	callbacks := len(source.callbacks)
+	source.mu.Unlock()
+	require.Zero(t, callbacks)
+
+	result := WithTimeout(source, time.Hour)
+	source.Complete(42)
+	value, err := requireDone(t, result)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+}
+
+func TestWithTimeoutAll(t *testing.T) {
+	a, b := Delay(5*time.Millisecond, 1), New[int]()
+	_, err := requireDone(t, WithTimeout(All(a, b), 20*time.Millisecond))
+	require.ErrorIs(t, err, ErrTimeout)
+}
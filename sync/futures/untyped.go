@@ -42,11 +42,36 @@ func (untyped *Untyped) Fail(err error) {
 	untyped.future().Fail(err)
 }
 
+// TryCompleteUntyped attempts to set a future value and mark it as completed.
+// Return value is true if this call has completed the future.
+func (untyped *Untyped) TryCompleteUntyped(value any) (ok bool) {
+	return untyped.future().TryComplete(value)
+}
+
+// TryFail attempts to complete a future with an error.
+// Return value is true if this call has completed the future.
+func (untyped *Untyped) TryFail(err error) (ok bool) {
+	return untyped.future().TryFail(err)
+}
+
 // GetUntyped returns a future result and a completion status.
 func (untyped *Untyped) GetUntyped() (value any, err error, ok bool) {
 	return untyped.future().Get()
 }
 
+// Reader returns a read-only view of the future.
+func (untyped *Untyped) Reader() Reader[any] {
+	return untyped.future().Reader()
+}
+
+func (untyped *Untyped) acquire() {
+	untyped.future().acquire()
+}
+
+func (untyped *Untyped) release(propagate bool) {
+	untyped.future().release(propagate)
+}
+
 func (untyped *Untyped) future() *Future[any] {
 	return (*Future[any])(untyped)
 }